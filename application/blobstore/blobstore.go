@@ -0,0 +1,25 @@
+package blobstore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+// ErrNotFound is returned by Get for keys without a blob.
+var ErrNotFound = errors.New("blob not found")
+
+// Store keeps immutable blobs. Blobs are put under the hash of their
+// content (see Key), so putting the same content twice is harmless.
+type Store interface {
+	Put(key string, data []byte) error
+	Get(key string) ([]byte, error)
+	Exists(key string) (bool, error)
+	Delete(key string) error
+}
+
+// Key is the content address of data.
+func Key(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
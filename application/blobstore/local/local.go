@@ -0,0 +1,94 @@
+package local
+
+import (
+	"errors"
+	"io/ioutil"
+	"liokoredu/application/blobstore"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStore keeps blobs as files in a single directory. Files of solutions
+// saved before blobs were content addressed live there too and are read the
+// same way.
+type LocalStore struct {
+	dir string
+}
+
+func (ls *LocalStore) path(key string) (string, error) {
+	if key == "" || strings.ContainsAny(key, `/\`) || strings.HasPrefix(key, ".") {
+		return "", errors.New("invalid blob key " + key)
+	}
+	return filepath.Join(ls.dir, key), nil
+}
+
+// Put writes to a temporary file first, so readers never see a partial blob.
+func (ls *LocalStore) Put(key string, data []byte) error {
+	p, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(ls.dir, ".put")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), p)
+}
+
+func (ls *LocalStore) Get(key string) ([]byte, error) {
+	p, err := ls.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return nil, blobstore.ErrNotFound
+	}
+	return data, err
+}
+
+func (ls *LocalStore) Exists(key string) (bool, error) {
+	p, err := ls.path(key)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(p)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (ls *LocalStore) Delete(key string) error {
+	p, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(p)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func NewLocalStore(dir string) (blobstore.Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &LocalStore{dir: dir}, nil
+}
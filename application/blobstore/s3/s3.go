@@ -0,0 +1,187 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"liokoredu/application/blobstore"
+	"liokoredu/pkg/constants"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config of an S3 compatible storage. Objects are addressed path style,
+// so it works with MinIO and the like as well.
+type Config struct {
+	Endpoint  string
+	Bucket    string
+	Region    string
+	AccessKey string
+	SecretKey string
+}
+
+// S3Store keeps blobs as objects of a bucket.
+type S3Store struct {
+	cfg    Config
+	client *http.Client
+}
+
+func (s *S3Store) do(method string, key string, body []byte) (*http.Response, []byte, error) {
+	url := strings.TrimRight(s.cfg.Endpoint, "/") + "/" + s.cfg.Bucket + "/" + key
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	Sign(req, body, s.cfg, time.Now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	answer, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, answer, nil
+}
+
+func (s *S3Store) Put(key string, data []byte) error {
+	resp, answer, err := s.do(http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return statusError(resp, answer)
+	}
+
+	return nil
+}
+
+func (s *S3Store) Get(key string) ([]byte, error) {
+	resp, answer, err := s.do(http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, blobstore.ErrNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, statusError(resp, answer)
+	}
+
+	return answer, nil
+}
+
+func (s *S3Store) Exists(key string) (bool, error) {
+	resp, answer, err := s.do(http.MethodHead, key, nil)
+	if err != nil {
+		return false, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+
+	return false, statusError(resp, answer)
+}
+
+func (s *S3Store) Delete(key string) error {
+	resp, answer, err := s.do(http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	}
+
+	return statusError(resp, answer)
+}
+
+func statusError(resp *http.Response, answer []byte) error {
+	return errors.New("s3 answered " + strconv.Itoa(resp.StatusCode) + ": " + string(answer))
+}
+
+// Sign adds AWS Signature Version 4 headers to the request. Every header
+// already set on the request is signed along with the host.
+func Sign(req *http.Request, body []byte, cfg Config, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := hashHex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	canonicalHeaders := ""
+	for _, name := range names {
+		canonicalHeaders += name + ":" + headers[name] + "\n"
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	query := strings.Replace(req.URL.Query().Encode(), "+", "%20", -1)
+
+	canonicalRequest := strings.Join([]string{
+		req.Method, path, query, canonicalHeaders, signedHeaders, payloadHash,
+	}, "\n")
+
+	scope := date + "/" + cfg.Region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hashHex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+cfg.SecretKey), date)
+	key = hmacSHA256(key, cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+cfg.AccessKey+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func NewS3Store(cfg Config) blobstore.Store {
+	return &S3Store{
+		cfg:    cfg,
+		client: &http.Client{Timeout: constants.BlobTimeout},
+	}
+}
@@ -0,0 +1,14 @@
+package checker
+
+import "liokoredu/application/models"
+
+// Checker is a judge backend. Submit hands a solution over for checking,
+// Poll reports its verdict once it is ready and Cancel drops a job that is
+// no longer needed. Jobs are identified by solution id. Run executes a
+// program on custom input and waits for the result, nothing is stored.
+type Checker interface {
+	Submit(ss *models.SolutionSend) error
+	Poll(id uint64) (*models.SolutionUpdate, bool, error)
+	Cancel(id uint64) error
+	Run(rs *models.RunSend) (*models.RunResult, error)
+}
@@ -0,0 +1,86 @@
+package checker
+
+import (
+	"liokoredu/application/models"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Compare checks the actual output against the expected one with a built-in
+// checker mode. Custom checkers are run by the judge and can't be compared here.
+func Compare(tc models.TaskChecker, expected string, actual string) bool {
+	switch tc.Mode {
+	case models.CheckerModeExact, "":
+		return expected == actual
+	case models.CheckerModeTokens:
+		return equalStrings(strings.Fields(expected), strings.Fields(actual))
+	case models.CheckerModeWhitespace:
+		return equalStrings(normalizeLines(expected), normalizeLines(actual))
+	case models.CheckerModeUnorderedLines:
+		e, a := normalizeLines(expected), normalizeLines(actual)
+		sort.Strings(e)
+		sort.Strings(a)
+		return equalStrings(e, a)
+	case models.CheckerModeFloat:
+		return equalFloats(strings.Fields(expected), strings.Fields(actual), tc.AbsEpsilon, tc.RelEpsilon)
+	}
+
+	return false
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeLines collapses space runs, trims lines and drops trailing empty lines.
+func normalizeLines(s string) []string {
+	lines := strings.Split(strings.Replace(s, "\r\n", "\n", -1), "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// equalFloats compares tokens, those which are numbers in both outputs are
+// equal when they are close enough by absolute or relative difference.
+func equalFloats(expected []string, actual []string, absEps float64, relEps float64) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		e, errE := strconv.ParseFloat(expected[i], 64)
+		a, errA := strconv.ParseFloat(actual[i], 64)
+		if errE != nil || errA != nil {
+			if expected[i] != actual[i] {
+				return false
+			}
+			continue
+		}
+
+		if math.IsNaN(e) || math.IsNaN(a) {
+			if !(math.IsNaN(e) && math.IsNaN(a)) {
+				return false
+			}
+			continue
+		}
+
+		diff := math.Abs(e - a)
+		if diff > absEps && diff > relEps*math.Abs(e) {
+			return false
+		}
+	}
+	return true
+}
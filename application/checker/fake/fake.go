@@ -0,0 +1,89 @@
+package fake
+
+import (
+	"liokoredu/application/checker"
+	"liokoredu/application/models"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo"
+)
+
+// JudgeFunc decides the verdict of a submitted solution.
+type JudgeFunc func(ss *models.SolutionSend) models.SolutionUpdate
+
+// FakeChecker judges solutions in-process without running them.
+// It is meant for tests and local development.
+type FakeChecker struct {
+	judge JudgeFunc
+
+	lock      sync.Mutex
+	results   map[uint64]*models.SolutionUpdate
+	Submitted []models.SolutionSend
+}
+
+// AcceptAll passes every test of every solution, as if the program
+// printed exactly the expected output.
+func AcceptAll(ss *models.SolutionSend) models.SolutionUpdate {
+	runs := models.TestRuns{}
+	for _, test := range ss.Tests {
+		runs = append(runs, models.TestRun{Passed: true, Stdout: test[1]})
+	}
+
+	return models.SolutionUpdate{
+		Code:       models.VerdictAccepted,
+		Passed:     len(ss.Tests),
+		TestsTotal: len(ss.Tests),
+		Tests:      runs,
+	}
+}
+
+func (fc *FakeChecker) Submit(ss *models.SolutionSend) error {
+	update := fc.judge(ss)
+	update.CheckedDateTime = time.Now()
+
+	fc.lock.Lock()
+	fc.results[ss.Id] = &update
+	fc.Submitted = append(fc.Submitted, *ss)
+	fc.lock.Unlock()
+
+	return nil
+}
+
+func (fc *FakeChecker) Poll(id uint64) (*models.SolutionUpdate, bool, error) {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+
+	update, ok := fc.results[id]
+	if !ok {
+		return nil, false, echo.NewHTTPError(http.StatusNotFound, "no checking job for solution "+strconv.FormatUint(id, 10))
+	}
+	delete(fc.results, id)
+
+	return update, true, nil
+}
+
+func (fc *FakeChecker) Cancel(id uint64) error {
+	fc.lock.Lock()
+	delete(fc.results, id)
+	fc.lock.Unlock()
+
+	return nil
+}
+
+func (fc *FakeChecker) Run(rs *models.RunSend) (*models.RunResult, error) {
+	update := fc.judge(checker.RunSolutionSend(rs))
+	return checker.RunResultOf(&update), nil
+}
+
+func NewFakeChecker(judge JudgeFunc) checker.Checker {
+	if judge == nil {
+		judge = AcceptAll
+	}
+	return &FakeChecker{
+		judge:   judge,
+		results: make(map[uint64]*models.SolutionUpdate),
+	}
+}
@@ -0,0 +1,64 @@
+package checker
+
+import (
+	"liokoredu/application/models"
+	"liokoredu/pkg/constants"
+	"time"
+)
+
+// Deadline is how long the judge may take to check the solution. It
+// compiles the solution and a custom checker, then runs the tests one by
+// one, each under a wall timer of twice the time limit and a second, the
+// checker gets some slack on top of it.
+func Deadline(ss *models.SolutionSend) time.Duration {
+	tl := ss.Limits.WithDefaults()
+
+	compile := constants.JudgeCompileTimeout
+	perTest := time.Duration(tl.Time)*time.Millisecond*2 + time.Second
+	if ss.Checker.Mode == models.CheckerModeCustom {
+		compile *= 2
+		perTest += constants.JudgeCheckerTimeout
+	}
+
+	return compile + time.Duration(len(ss.Tests))*perTest + constants.CheckerTimeout
+}
+
+// EnforceLimits fails test runs which went over the task limits in case the
+// judge let them pass, and gives the solution the verdict of the first of
+// them if it was accepted.
+func EnforceLimits(tl models.TaskLimits, update *models.SolutionUpdate) {
+	tl = tl.WithDefaults()
+
+	verdict := models.VerdictAccepted
+	for i := range update.Tests {
+		run := &update.Tests[i]
+		if !run.Passed {
+			continue
+		}
+
+		switch {
+		case run.RunTime*1000 > float32(tl.Time):
+			run.Verdict = models.VerdictTimeLimit
+		case run.Memory > int64(tl.Memory):
+			run.Verdict = models.VerdictMemoryLimit
+		case len(run.Stdout) > tl.Output*1024:
+			run.Verdict = models.VerdictOutputLimit
+		default:
+			continue
+		}
+
+		run.Passed = false
+		update.Passed--
+		if verdict == models.VerdictAccepted {
+			verdict = run.Verdict
+		}
+	}
+
+	if update.Passed < 0 {
+		update.Passed = 0
+	}
+	if verdict != models.VerdictAccepted && update.Code == models.VerdictAccepted {
+		update.Code = verdict
+		update.CheckMessage = verdict.Message()
+	}
+}
@@ -0,0 +1,360 @@
+package local
+
+import (
+	"io/ioutil"
+	"liokoredu/application/checker"
+	"liokoredu/application/language"
+	"liokoredu/application/models"
+	"liokoredu/pkg/constants"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo"
+)
+
+// Config of the embedded judge.
+type Config struct {
+	// Directory for temporary workspaces, system default when empty.
+	WorkDir string
+	// Command every program is started with, e.g. a seccomp or nsjail
+	// launcher. Programs are appended to it as `sh -c <command>`.
+	Wrapper []string
+	// Run programs in separate Linux namespaces without network access.
+	Namespaces bool
+}
+
+type job struct {
+	update *models.SolutionUpdate
+	cancel chan struct{}
+}
+
+// LocalChecker compiles and runs solutions on this machine. Every job gets
+// its own workspace which is removed once the verdict is ready.
+type LocalChecker struct {
+	cfg    Config
+	runner *runner
+
+	lock sync.Mutex
+	jobs map[uint64]*job
+}
+
+func (lc *LocalChecker) Submit(ss *models.SolutionSend) error {
+	j := &job{cancel: make(chan struct{})}
+
+	lc.lock.Lock()
+	if old, ok := lc.jobs[ss.Id]; ok && old.update == nil {
+		close(old.cancel)
+	}
+	lc.jobs[ss.Id] = j
+	lc.lock.Unlock()
+
+	go func() {
+		update := lc.judge(ss, j.cancel)
+		update.CheckedDateTime = time.Now()
+
+		lc.lock.Lock()
+		j.update = update
+		lc.lock.Unlock()
+	}()
+
+	return nil
+}
+
+func (lc *LocalChecker) Poll(id uint64) (*models.SolutionUpdate, bool, error) {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	j, ok := lc.jobs[id]
+	if !ok {
+		return nil, false, echo.NewHTTPError(http.StatusNotFound, "no checking job for solution "+strconv.FormatUint(id, 10))
+	}
+	if j.update == nil {
+		return nil, false, nil
+	}
+	delete(lc.jobs, id)
+
+	return j.update, true, nil
+}
+
+func (lc *LocalChecker) Cancel(id uint64) error {
+	lc.lock.Lock()
+	defer lc.lock.Unlock()
+
+	if j, ok := lc.jobs[id]; ok {
+		if j.update == nil {
+			close(j.cancel)
+		}
+		delete(lc.jobs, id)
+	}
+
+	return nil
+}
+
+// Run judges the program right away in the calling goroutine.
+func (lc *LocalChecker) Run(rs *models.RunSend) (*models.RunResult, error) {
+	update := lc.judge(checker.RunSolutionSend(rs), make(chan struct{}))
+	return checker.RunResultOf(update), nil
+}
+
+// judge builds the solution and runs it on every test.
+func (lc *LocalChecker) judge(ss *models.SolutionSend, cancel <-chan struct{}) *models.SolutionUpdate {
+	update := &models.SolutionUpdate{
+		Status:     models.SolutionStatusChecked,
+		TestsTotal: len(ss.Tests),
+		Tests:      models.TestRuns{},
+	}
+	limits := ss.Limits.WithDefaults()
+
+	dir, err := ioutil.TempDir(lc.cfg.WorkDir, "judge")
+	if err != nil {
+		log.Println("local checker: judge: error creating workspace", err)
+		return judgeError(update, err)
+	}
+	defer os.RemoveAll(dir)
+
+	if msg := writeFiles(dir, ss.SourceCode); msg != "" {
+		update.Code = models.VerdictCompilationError
+		update.CheckMessage = msg
+		return update
+	}
+
+	res, err := lc.compile(dir, ss.Language.Compile, cancel)
+	if err != nil {
+		return judgeError(update, err)
+	}
+	if res != nil {
+		update.CompileTime = res.time
+		if res.exitCode != 0 || res.timedOut {
+			update.Code = models.VerdictCompilationError
+			update.CheckMessage = res.stderr + res.stdout
+			return update
+		}
+	}
+
+	cmp, err := lc.prepareChecker(ss.Checker, cancel)
+	if cmp != nil && cmp.dir != "" {
+		defer os.RemoveAll(cmp.dir)
+	}
+	if err != nil {
+		return judgeError(update, err)
+	}
+
+	update.Code = models.VerdictAccepted
+	for i, test := range ss.Tests {
+		run, err := lc.runTest(dir, ss.Language.Run, test, limits, cmp, cancel)
+		if err != nil {
+			return judgeError(update, err)
+		}
+
+		update.Tests = append(update.Tests, *run)
+		update.CheckTime += run.RunTime
+		if run.Passed {
+			update.Passed++
+		} else if update.Code == models.VerdictAccepted {
+			update.Code = run.Verdict
+			update.CheckMessage = run.Verdict.Message() + " on test " + strconv.Itoa(i+1)
+		}
+	}
+
+	return update
+}
+
+func (lc *LocalChecker) compile(dir string, cmd string, cancel <-chan struct{}) (*runResult, error) {
+	if cmd == "" {
+		return nil, nil
+	}
+
+	return lc.runner.run(dir, cmd, "", limits{
+		time:   int(constants.JudgeCompileTimeout / time.Millisecond),
+		memory: constants.JudgeCompileMemory,
+		output: constants.TestStdoutLength / 1024,
+	}, cancel)
+}
+
+// outputChecker compares outputs, custom checkers are built beforehand and
+// get paths of the input, expected and actual outputs as arguments.
+type outputChecker struct {
+	tc  models.TaskChecker
+	dir string
+	run string
+}
+
+func (lc *LocalChecker) prepareChecker(tc models.TaskChecker, cancel <-chan struct{}) (*outputChecker, error) {
+	cmp := &outputChecker{tc: tc}
+	if tc.Mode != models.CheckerModeCustom {
+		return cmp, nil
+	}
+
+	langId := tc.Language
+	if langId == "" {
+		langId = constants.DefaultLanguage
+	}
+	lang, ok := language.Get(langId)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "unknown checker language "+langId)
+	}
+
+	// out of the solution workspace, so the solution can't spoil it
+	checkerDir, err := ioutil.TempDir(lc.cfg.WorkDir, "checker")
+	if err != nil {
+		return nil, err
+	}
+	cmp.dir = checkerDir
+	cmp.run = "exec " + lang.Run
+	if msg := writeFiles(cmp.dir, tc.SourceCode); msg != "" {
+		return cmp, echo.NewHTTPError(http.StatusInternalServerError, "checker: "+msg)
+	}
+
+	res, err := lc.compile(cmp.dir, lang.Compile, cancel)
+	if err != nil {
+		return cmp, err
+	}
+	if res != nil && (res.exitCode != 0 || res.timedOut) {
+		return cmp, echo.NewHTTPError(http.StatusInternalServerError, "checker does not compile: "+res.stderr)
+	}
+
+	return cmp, nil
+}
+
+func (lc *LocalChecker) runTest(dir string, cmd string, test []string, tl models.TaskLimits,
+	cmp *outputChecker, cancel <-chan struct{}) (*models.TestRun, error) {
+	var stdin, expected string
+	if len(test) > 0 {
+		stdin = test[0]
+	}
+	if len(test) > 1 {
+		expected = test[1]
+	}
+
+	// exec keeps the pid of the shell, so memory of the program is sampled
+	res, err := lc.runner.run(dir, "exec "+cmd, stdin, limits{time: tl.Time, memory: tl.Memory, output: tl.Output}, cancel)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &models.TestRun{
+		Stdout:  res.stdout,
+		Stderr:  res.stderr,
+		RunTime: res.time,
+		Memory:  res.memory,
+	}
+
+	switch {
+	case res.timedOut || res.cpuExceeded || res.time*1000 > float32(tl.Time):
+		run.Verdict = models.VerdictTimeLimit
+	case res.outputExceeded || res.fileExceeded:
+		run.Verdict = models.VerdictOutputLimit
+	case res.memoryExceeded || res.memory > int64(tl.Memory):
+		run.Verdict = models.VerdictMemoryLimit
+	case res.exitCode != 0:
+		run.Verdict = models.VerdictRuntimeError
+	default:
+		ok, err := lc.check(cmp, stdin, expected, res.stdout, cancel)
+		if err != nil {
+			return nil, err
+		}
+		run.Passed = ok
+		if !ok {
+			run.Verdict = models.VerdictWrongAnswer
+		}
+	}
+
+	return run, nil
+}
+
+func (lc *LocalChecker) check(cmp *outputChecker, stdin string, expected string, actual string,
+	cancel <-chan struct{}) (bool, error) {
+	if cmp.tc.Mode != models.CheckerModeCustom {
+		return checker.Compare(cmp.tc, expected, actual), nil
+	}
+
+	// the expected output is written only after the program has finished
+	// and is removed right after the check, so no judged program can see it
+	checkDir, err := ioutil.TempDir(lc.cfg.WorkDir, "check")
+	if err != nil {
+		return false, err
+	}
+	defer os.RemoveAll(checkDir)
+
+	run := cmp.run
+	for _, f := range []struct{ name, content string }{
+		{"input.txt", stdin}, {"expected.txt", expected}, {"output.txt", actual},
+	} {
+		path := filepath.Join(checkDir, f.name)
+		if err := ioutil.WriteFile(path, []byte(f.content), 0600); err != nil {
+			return false, err
+		}
+		run += " " + shellQuote(path)
+	}
+
+	res, err := lc.runner.run(cmp.dir, run, "", limits{
+		time:   int(constants.JudgeCheckerTimeout / time.Millisecond),
+		memory: constants.JudgeCompileMemory,
+		output: constants.TestStdoutLength / 1024,
+	}, cancel)
+	if err != nil {
+		return false, err
+	}
+	if res.timedOut {
+		return false, echo.NewHTTPError(http.StatusInternalServerError, "checker did not finish in time")
+	}
+
+	return res.exitCode == 0, nil
+}
+
+// writeFiles puts solution files into dir, it returns a message if they
+// can't be written.
+func writeFiles(dir string, code map[string]interface{}) string {
+	for name, content := range code {
+		if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+			return "invalid file name " + name
+		}
+		text, ok := content.(string)
+		if !ok {
+			return "file " + name + " is not a text"
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(text), 0600); err != nil {
+			log.Println("local checker: writeFiles: error writing", name, err)
+			return "unable to write file " + name
+		}
+	}
+
+	return ""
+}
+
+// shellQuote makes s a single word for sh.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+func judgeError(update *models.SolutionUpdate, err error) *models.SolutionUpdate {
+	update.Code = models.VerdictJudgeError
+	update.CheckMessage = err.Error()
+	return update
+}
+
+// NewLocalChecker creates the embedded judge. Resource limits are set with
+// prlimit, without it only time and output limits are enforced.
+func NewLocalChecker(cfg Config) checker.Checker {
+	prlimit, err := exec.LookPath("prlimit")
+	if err != nil {
+		log.Println("local checker: prlimit is not found, memory limits are not enforced")
+	}
+
+	return &LocalChecker{
+		cfg: cfg,
+		runner: &runner{
+			wrapper:    cfg.Wrapper,
+			prlimit:    prlimit,
+			namespaces: cfg.Namespaces,
+		},
+		jobs: make(map[uint64]*job),
+	}
+}
@@ -0,0 +1,175 @@
+package local
+
+import (
+	"bytes"
+	"liokoredu/pkg/constants"
+	"os/exec"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// limits of a single program run: time in milliseconds, memory and output
+// in kilobytes. Zero memory means no memory limit.
+type limits struct {
+	time   int
+	memory int
+	output int
+}
+
+type runResult struct {
+	stdout   string
+	stderr   string
+	exitCode int
+	// cpu time in seconds
+	time float32
+	// peak memory in kilobytes, sampled while the program runs
+	memory int64
+
+	timedOut       bool
+	outputExceeded bool
+	memoryExceeded bool
+	// the program was stopped by cpu time or file size rlimit
+	cpuExceeded  bool
+	fileExceeded bool
+}
+
+// runner starts programs with the configured wrapper, rlimits and namespaces.
+type runner struct {
+	wrapper    []string
+	prlimit    string
+	namespaces bool
+}
+
+// command builds `wrapper... prlimit --as --cpu --fsize -- sh -c cmd`.
+func (r *runner) command(cmd string, l limits) *exec.Cmd {
+	args := append([]string{}, r.wrapper...)
+	if r.prlimit != "" {
+		args = append(args, r.prlimit,
+			"--cpu="+strconv.Itoa(l.time/1000+1),
+			"--fsize="+strconv.Itoa((l.output+1)*1024),
+			"--core=0")
+		if l.memory > 0 {
+			// address space is bigger than resident memory, the memory
+			// limit itself is checked by sampling
+			args = append(args, "--as="+strconv.Itoa(l.memory*1024*2))
+		}
+		args = append(args, "--")
+	}
+	args = append(args, "sh", "-c", cmd)
+
+	c := exec.Command(args[0], args[1:]...)
+	c.SysProcAttr = sysProcAttr(r.namespaces)
+	return c
+}
+
+// run executes cmd in dir feeding it stdin. The program is killed when it
+// runs for too long by the wall clock, takes or prints more than allowed or
+// the job is cancelled.
+func (r *runner) run(dir string, cmd string, stdin string, l limits, cancel <-chan struct{}) (*runResult, error) {
+	c := r.command(cmd, l)
+	c.Dir = dir
+	c.Stdin = bytes.NewBufferString(stdin)
+
+	res := &runResult{}
+	stdout := &limitedBuffer{limit: l.output * 1024}
+	stderr := &limitedBuffer{limit: constants.TestStderrLength}
+	c.Stdout = stdout
+	c.Stderr = stderr
+
+	var once sync.Once
+	kill := func() { once.Do(func() { killGroup(c.Process) }) }
+	stdout.onOverflow = kill
+
+	started := time.Now()
+	if err := c.Start(); err != nil {
+		return nil, err
+	}
+
+	finished := make(chan struct{})
+	watched := make(chan struct{})
+	wall := time.Duration(l.time)*time.Millisecond*2 + time.Second
+	go func() {
+		defer close(watched)
+		timer := time.NewTimer(wall)
+		defer timer.Stop()
+		sample := time.NewTicker(constants.JudgeMemorySampleInterval)
+		defer sample.Stop()
+		for {
+			if m := peakMemory(c.Process.Pid); m > res.memory {
+				res.memory = m
+				if l.memory > 0 && m > int64(l.memory) {
+					res.memoryExceeded = true
+					kill()
+					return
+				}
+			}
+			select {
+			case <-finished:
+				return
+			case <-cancel:
+				kill()
+				return
+			case <-timer.C:
+				res.timedOut = true
+				kill()
+				return
+			case <-sample.C:
+			}
+		}
+	}()
+
+	err := c.Wait()
+	close(finished)
+	<-watched
+	// nothing the program has spawned may outlive it, e.g. to wait for
+	// files of the checker
+	killGroup(c.Process)
+	if _, ok := err.(*exec.ExitError); err != nil && !ok {
+		return nil, err
+	}
+
+	res.stdout = stdout.String()
+	res.stderr = stderr.String()
+	res.exitCode = c.ProcessState.ExitCode()
+	res.time = float32((c.ProcessState.UserTime() + c.ProcessState.SystemTime()).Seconds())
+	if res.timedOut && res.time < float32(time.Since(started).Seconds()) {
+		// sleeping programs hardly use cpu, count the wall time for them
+		res.time = float32(time.Since(started).Seconds())
+	}
+	res.cpuExceeded, res.fileExceeded = limitSignals(c.ProcessState)
+	res.outputExceeded = stdout.overflow
+
+	return res, nil
+}
+
+// limitedBuffer keeps up to limit bytes, the rest is dropped. Output of
+// programs is limited, so onOverflow is called to stop them. The buffer is
+// not embedded on purpose, its ReadFrom would bypass the limit in io.Copy.
+type limitedBuffer struct {
+	buf        bytes.Buffer
+	limit      int
+	overflow   bool
+	onOverflow func()
+}
+
+func (lb *limitedBuffer) Write(p []byte) (int, error) {
+	if free := lb.limit - lb.buf.Len(); len(p) > free {
+		if free > 0 {
+			lb.buf.Write(p[:free])
+		}
+		if !lb.overflow {
+			lb.overflow = true
+			if lb.onOverflow != nil {
+				lb.onOverflow()
+			}
+		}
+		return len(p), nil
+	}
+
+	return lb.buf.Write(p)
+}
+
+func (lb *limitedBuffer) String() string {
+	return lb.buf.String()
+}
@@ -0,0 +1,62 @@
+//go:build linux
+// +build linux
+
+package local
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// sysProcAttr puts a judged program into its own process group and, when
+// asked, into fresh user, mount, pid, network, ipc and uts namespaces, so it
+// can't reach the network or see other processes.
+func sysProcAttr(namespaces bool) *syscall.SysProcAttr {
+	attr := &syscall.SysProcAttr{Setpgid: true, Pdeathsig: syscall.SIGKILL}
+	if !namespaces {
+		return attr
+	}
+
+	attr.Cloneflags = syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS | syscall.CLONE_NEWPID |
+		syscall.CLONE_NEWNET | syscall.CLONE_NEWIPC | syscall.CLONE_NEWUTS
+	attr.UidMappings = []syscall.SysProcIDMap{{ContainerID: os.Getuid(), HostID: os.Getuid(), Size: 1}}
+	attr.GidMappings = []syscall.SysProcIDMap{{ContainerID: os.Getgid(), HostID: os.Getgid(), Size: 1}}
+	return attr
+}
+
+// killGroup kills the program together with everything it spawned.
+func killGroup(p *os.Process) {
+	_ = syscall.Kill(-p.Pid, syscall.SIGKILL)
+	_ = p.Kill()
+}
+
+// limitSignals tells whether the program was killed for going over cpu
+// time or file size rlimits.
+func limitSignals(ps *os.ProcessState) (bool, bool) {
+	ws, ok := ps.Sys().(syscall.WaitStatus)
+	if !ok || !ws.Signaled() {
+		return false, false
+	}
+	return ws.Signal() == syscall.SIGXCPU, ws.Signal() == syscall.SIGXFSZ
+}
+
+// peakMemory reads peak resident memory of a running process in kilobytes.
+// Rusage of the process can't be used for it: the child is forked from this
+// server and its max rss starts with the memory of the server.
+func peakMemory(pid int) int64 {
+	status, err := ioutil.ReadFile("/proc/" + strconv.Itoa(pid) + "/status")
+	if err != nil {
+		return 0
+	}
+
+	for _, line := range strings.Split(string(status), "\n") {
+		if strings.HasPrefix(line, "VmHWM:") {
+			kb, _ := strconv.ParseInt(strings.Fields(line)[1], 10, 64)
+			return kb
+		}
+	}
+	return 0
+}
@@ -0,0 +1,27 @@
+//go:build !linux
+// +build !linux
+
+package local
+
+import (
+	"os"
+	"syscall"
+)
+
+// Namespaces are Linux only, elsewhere programs run as plain processes
+// and only time and output limits are enforced.
+func sysProcAttr(namespaces bool) *syscall.SysProcAttr {
+	return nil
+}
+
+func killGroup(p *os.Process) {
+	_ = p.Kill()
+}
+
+func limitSignals(ps *os.ProcessState) (bool, bool) {
+	return false, false
+}
+
+func peakMemory(pid int) int64 {
+	return 0
+}
@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"liokoredu/application/checker"
+	"liokoredu/application/checker/local"
+	"liokoredu/application/language"
+	"liokoredu/application/models"
+)
+
+func judge(t *testing.T, c checker.Checker, ss *models.SolutionSend) *models.SolutionUpdate {
+	if err := c.Submit(ss); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	deadline := time.Now().Add(30 * time.Second)
+	for time.Now().Before(deadline) {
+		update, done, err := c.Poll(ss.Id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if done {
+			return update
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	t.Fatalf("solution %d was not judged in time", ss.Id)
+	return nil
+}
+
+func TestLocalChecker(t *testing.T) {
+	if _, err := exec.LookPath("gcc"); err != nil {
+		t.Skip("gcc is not installed")
+	}
+	c, _ := language.Get("c")
+	tests := models.InputTests{{"1 2", "3"}, {"3 4", "7"}}
+
+	cases := []struct {
+		name    string
+		code    string
+		verdict models.Verdict
+		passed  int
+	}{
+		{"accepted", `#include <stdio.h>
+int main() { int a, b; scanf("%d %d", &a, &b); printf("%d", a + b); return 0; }`, models.VerdictAccepted, 2},
+		{"wrong answer", `#include <stdio.h>
+int main() { int a, b; scanf("%d %d", &a, &b); printf("%d", a + b == 3 ? 3 : 0); return 0; }`,
+			models.VerdictWrongAnswer, 1},
+		{"compilation error", `int main() { return }`, models.VerdictCompilationError, 0},
+		{"runtime error", `int main() { return 1; }`, models.VerdictRuntimeError, 0},
+		{"time limit", `int main() { for (;;); }`, models.VerdictTimeLimit, 0},
+		{"output limit", `#include <stdio.h>
+int main() { for (;;) putchar('a'); }`, models.VerdictOutputLimit, 0},
+		{"memory limit", `#include <stdlib.h>
+#include <string.h>
+#include <unistd.h>
+int main() { for (int i = 0; i < 256; i++) { memset(malloc(1 << 20), 1, 1 << 20); usleep(2000); } return 0; }`,
+			models.VerdictMemoryLimit, 0},
+	}
+
+	lc := local.NewLocalChecker(local.Config{})
+	for i, tc := range cases {
+		update := judge(t, lc, &models.SolutionSend{
+			Id:         uint64(i),
+			SourceCode: map[string]interface{}{"main.c": tc.code},
+			Tests:      tests,
+			Language:   c,
+			Limits:     models.TaskLimits{Time: 300, Memory: 32 * 1024, Output: 1},
+		})
+
+		if update.Code != tc.verdict || update.Passed != tc.passed {
+			t.Errorf("%s: got %s with %d passed: %s", tc.name, update.Code, update.Passed, update.CheckMessage)
+		}
+	}
+}
+
+func TestLocalCheckerCustom(t *testing.T) {
+	if _, err := exec.LookPath("python3"); err != nil {
+		t.Skip("python3 is not installed")
+	}
+	python, _ := language.Get("python")
+
+	// any divisor of the number is a right answer
+	lc := local.NewLocalChecker(local.Config{})
+	update := judge(t, lc, &models.SolutionSend{
+		Id:         1,
+		SourceCode: map[string]interface{}{"main.py": "n = int(input())\nprint(n if n % 2 else 2)\n"},
+		Tests:      models.InputTests{{"12", "3"}, {"9", "3"}},
+		Language:   python,
+		Checker: models.TaskChecker{
+			Mode:     models.CheckerModeCustom,
+			Language: "python",
+			SourceCode: map[string]interface{}{"main.py": `import sys
+n = int(open(sys.argv[1]).read())
+d = int(open(sys.argv[3]).read())
+sys.exit(0 if 1 < d <= n and n % d == 0 else 1)
+`},
+		},
+	})
+
+	if update.Code != models.VerdictAccepted || update.Passed != 2 {
+		t.Errorf("got %s with %d passed: %s", update.Code, update.Passed, update.CheckMessage)
+	}
+}
+
+func TestLocalCheckerHidesExpected(t *testing.T) {
+	if _, err := exec.LookPath("python3"); err != nil {
+		t.Skip("python3 is not installed")
+	}
+	python, _ := language.Get("python")
+
+	// the solution looks for answers left by the checker of previous tests
+	work := t.TempDir()
+	lc := local.NewLocalChecker(local.Config{WorkDir: work})
+	update := judge(t, lc, &models.SolutionSend{
+		Id: 1,
+		SourceCode: map[string]interface{}{"main.py": `import glob
+found = [open(f).read() for f in glob.glob("` + work + `/*/*.txt")]
+print("answer" if any("answer" in f for f in found) else "none")
+`},
+		Tests:    models.InputTests{{"1", "answer"}, {"2", "answer"}},
+		Language: python,
+		Checker: models.TaskChecker{
+			Mode:     models.CheckerModeCustom,
+			Language: "python",
+			SourceCode: map[string]interface{}{"main.py": `import sys
+sys.exit(0 if open(sys.argv[3]).read().strip() == "none" else 1)
+`},
+		},
+	})
+
+	if update.Code != models.VerdictAccepted || update.Passed != 2 {
+		t.Errorf("expected output was seen by the solution: %s with %d passed", update.Code, update.Passed)
+	}
+}
+
+func TestLocalCheckerRun(t *testing.T) {
+	if _, err := exec.LookPath("python3"); err != nil {
+		t.Skip("python3 is not installed")
+	}
+	py, _ := language.Get("python")
+	lc := local.NewLocalChecker(local.Config{})
+
+	res, err := lc.Run(&models.RunSend{
+		SourceCode: map[string]interface{}{"main.py": "import sys\nprint(sys.stdin.read().upper())\n"},
+		Stdin:      "hello",
+		Language:   py,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Verdict != models.VerdictAccepted || res.Stdout != "HELLO\n" {
+		t.Errorf("got %s with output %q: %s", res.Verdict, res.Stdout, res.CheckMessage)
+	}
+
+	res, err = lc.Run(&models.RunSend{
+		SourceCode: map[string]interface{}{"main.py": "raise SystemExit(3)\n"},
+		Language:   py,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Verdict != models.VerdictRuntimeError {
+		t.Errorf("expected runtime error, got %s", res.Verdict)
+	}
+}
@@ -0,0 +1,121 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"liokoredu/application/checker"
+	"liokoredu/application/models"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/labstack/echo"
+)
+
+// RemoteChecker talks to the external python checking service. The service
+// answers synchronously, so Submit stores the verdict and Poll hands it out.
+type RemoteChecker struct {
+	address string
+	client  *http.Client
+
+	lock    sync.Mutex
+	results map[uint64]*models.SolutionUpdate
+}
+
+// check sends the solution to the service and waits for its verdict.
+func (rc *RemoteChecker) check(ss *models.SolutionSend) (*models.SolutionUpdate, error) {
+	reqBody, err := json.Marshal(ss)
+	if err != nil {
+		log.Println("remote checker: check: error marshaling SolutionSend", err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// the service answers once every test is run
+	ctx, cancel := context.WithTimeout(context.Background(), checker.Deadline(ss))
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rc.address, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := rc.client.Do(req)
+	if err != nil {
+		log.Println("remote checker: check: error sending solution", ss.Id, err)
+		return nil, echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		log.Println("remote checker: check: error reading answer", err)
+		return nil, echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		log.Println("remote checker: check: checker answered", resp.StatusCode, string(body))
+		return nil, echo.NewHTTPError(http.StatusBadGateway, "checker answered with status "+strconv.Itoa(resp.StatusCode))
+	}
+
+	update := &models.SolutionUpdate{}
+	if err = json.Unmarshal(body, update); err != nil {
+		log.Println("remote checker: check: error unmarshaling answer", err)
+		return nil, echo.NewHTTPError(http.StatusBadGateway, err.Error())
+	}
+
+	return update, nil
+}
+
+func (rc *RemoteChecker) Submit(ss *models.SolutionSend) error {
+	update, err := rc.check(ss)
+	if err != nil {
+		return err
+	}
+
+	rc.lock.Lock()
+	rc.results[ss.Id] = update
+	rc.lock.Unlock()
+
+	return nil
+}
+
+func (rc *RemoteChecker) Poll(id uint64) (*models.SolutionUpdate, bool, error) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	update, ok := rc.results[id]
+	if !ok {
+		return nil, false, echo.NewHTTPError(http.StatusNotFound, "no checking job for solution "+strconv.FormatUint(id, 10))
+	}
+	delete(rc.results, id)
+
+	return update, true, nil
+}
+
+func (rc *RemoteChecker) Cancel(id uint64) error {
+	rc.lock.Lock()
+	delete(rc.results, id)
+	rc.lock.Unlock()
+
+	return nil
+}
+
+func (rc *RemoteChecker) Run(rs *models.RunSend) (*models.RunResult, error) {
+	update, err := rc.check(checker.RunSolutionSend(rs))
+	if err != nil {
+		return nil, err
+	}
+
+	return checker.RunResultOf(update), nil
+}
+
+func NewRemoteChecker(address string) checker.Checker {
+	return &RemoteChecker{
+		address: address,
+		client:  &http.Client{},
+		results: make(map[uint64]*models.SolutionUpdate),
+	}
+}
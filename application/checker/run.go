@@ -0,0 +1,46 @@
+package checker
+
+import "liokoredu/application/models"
+
+// RunSolutionSend turns a one-off run into a solution with a single test,
+// so backends can judge it as usual.
+func RunSolutionSend(rs *models.RunSend) *models.SolutionSend {
+	return &models.SolutionSend{
+		SourceCode: rs.SourceCode,
+		Tests:      models.InputTests{{rs.Stdin, ""}},
+		Limits:     rs.Limits,
+		Language:   rs.Language,
+	}
+}
+
+// RunResultOf takes the result of a one-off run from the verdict of its
+// single test. The output is not checked, so a wrong answer means the
+// program finished normally.
+func RunResultOf(update *models.SolutionUpdate) *models.RunResult {
+	update.Status = models.SolutionStatusChecked
+	NormalizeVerdict(update)
+
+	res := &models.RunResult{
+		Verdict:      update.Code,
+		CheckMessage: update.CheckMessage,
+		CompileTime:  update.CompileTime,
+	}
+	if len(update.Tests) > 0 {
+		run := update.Tests[0]
+		res.Stdout = run.Stdout
+		res.Stderr = run.Stderr
+		res.RunTime = run.RunTime
+		res.Memory = run.Memory
+		res.Verdict = run.Verdict
+		res.CheckMessage = ""
+	}
+	if res.Verdict == models.VerdictWrongAnswer {
+		res.Verdict = models.VerdictAccepted
+	}
+	if res.Verdict != models.VerdictAccepted && res.CheckMessage == "" {
+		res.CheckMessage = res.Verdict.Message()
+	}
+	res.VerdictName = res.Verdict.String()
+
+	return res
+}
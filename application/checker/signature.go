@@ -0,0 +1,32 @@
+package checker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Headers of a signed checker callback.
+const (
+	TimestampHeader = "X-Checker-Timestamp"
+	NonceHeader     = "X-Checker-Nonce"
+	SignatureHeader = "X-Checker-Signature"
+)
+
+// Sign returns hex encoded HMAC-SHA256 of the callback. The timestamp and
+// the nonce are signed together with the body so neither can be swapped.
+func Sign(secret []byte, timestamp string, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature in constant time.
+func Verify(secret []byte, timestamp string, nonce string, body []byte, signature string) bool {
+	expected := Sign(secret, timestamp, nonce, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"liokoredu/application/checker"
+	"liokoredu/application/models"
+)
+
+func TestSignature(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"checkResult":0}`)
+
+	sig := checker.Sign(secret, "1650000000", "nonce", body)
+
+	if !checker.Verify(secret, "1650000000", "nonce", body, sig) {
+		t.Errorf("valid signature rejected")
+	}
+	if checker.Verify(secret, "1650000001", "nonce", body, sig) {
+		t.Errorf("signature with another timestamp accepted")
+	}
+	if checker.Verify(secret, "1650000000", "other", body, sig) {
+		t.Errorf("signature with another nonce accepted")
+	}
+	if checker.Verify(secret, "1650000000", "nonce", []byte(`{"checkResult":1}`), sig) {
+		t.Errorf("signature of another body accepted")
+	}
+	if checker.Verify([]byte("wrong"), "1650000000", "nonce", body, sig) {
+		t.Errorf("signature with another secret accepted")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		checker  models.TaskChecker
+		expected string
+		actual   string
+		ok       bool
+	}{
+		{models.TaskChecker{Mode: models.CheckerModeExact}, "3\n", "3\n", true},
+		{models.TaskChecker{Mode: models.CheckerModeExact}, "3\n", "3", false},
+		{models.TaskChecker{Mode: models.CheckerModeTokens}, "1 2\n3\n", "1\n2   3", true},
+		{models.TaskChecker{Mode: models.CheckerModeTokens}, "1 2 3", "1 2", false},
+		{models.TaskChecker{Mode: models.CheckerModeWhitespace}, "a  b\nc\n", "a b  \r\nc\n\n", true},
+		{models.TaskChecker{Mode: models.CheckerModeWhitespace}, "a b\nc", "a b c", false},
+		{models.TaskChecker{Mode: models.CheckerModeFloat, AbsEpsilon: 1e-6}, "0.333333", "0.3333333", true},
+		{models.TaskChecker{Mode: models.CheckerModeFloat, AbsEpsilon: 1e-6}, "0.33", "0.34", false},
+		{models.TaskChecker{Mode: models.CheckerModeFloat, RelEpsilon: 1e-3}, "1000000", "1000500", true},
+		{models.TaskChecker{Mode: models.CheckerModeFloat, AbsEpsilon: 1e-6}, "yes 1.0", "no 1.0", false},
+		{models.TaskChecker{Mode: models.CheckerModeUnorderedLines}, "a\nb\nc\n", "c\na\nb", true},
+		{models.TaskChecker{Mode: models.CheckerModeUnorderedLines}, "a\nb\n", "a\na\n", false},
+	}
+
+	for i, c := range cases {
+		if checker.Compare(c.checker, c.expected, c.actual) != c.ok {
+			t.Errorf("case %d (%s): expected %v", i, c.checker.Mode, c.ok)
+		}
+	}
+}
+
+func TestEnforceLimits(t *testing.T) {
+	limits := models.TaskLimits{Time: 1000, Memory: 1024, Output: 1}
+	update := &models.SolutionUpdate{
+		Code:   0,
+		Passed: 4,
+		Tests: models.TestRuns{
+			{Passed: true, RunTime: 0.5, Memory: 512, Stdout: "1"},
+			{Passed: true, RunTime: 1.5, Memory: 512},
+			{Passed: true, RunTime: 0.5, Memory: 2048},
+			{Passed: true, RunTime: 0.5, Stdout: strings.Repeat("a", 2048)},
+		},
+	}
+
+	checker.EnforceLimits(limits, update)
+
+	if update.Code != models.VerdictTimeLimit || update.Passed != 1 {
+		t.Fatalf("unexpected verdict %d with %d passed", update.Code, update.Passed)
+	}
+	verdicts := []models.Verdict{models.VerdictAccepted, models.VerdictTimeLimit, models.VerdictMemoryLimit, models.VerdictOutputLimit}
+	for i, run := range update.Tests {
+		if run.Verdict != verdicts[i] || run.Passed != (i == 0) {
+			t.Errorf("test %d: unexpected verdict %d", i, run.Verdict)
+		}
+	}
+}
+
+func TestNormalizeVerdict(t *testing.T) {
+	update := &models.SolutionUpdate{
+		Status: models.SolutionStatusChecked,
+		Code:   models.VerdictRunning,
+		Tests:  models.TestRuns{{Passed: true}, {Passed: false}},
+	}
+	checker.NormalizeVerdict(update)
+	if update.Code != models.VerdictJudgeError {
+		t.Errorf("non-final verdict of a checked solution must become a judge error, got %s", update.Code)
+	}
+
+	update = &models.SolutionUpdate{
+		Status: models.SolutionStatusChecked,
+		Code:   models.VerdictWrongAnswer,
+		Tests:  models.TestRuns{{Passed: true}, {Passed: false}},
+	}
+	checker.NormalizeVerdict(update)
+	if update.Tests[0].Verdict != models.VerdictAccepted || update.Tests[1].Verdict != models.VerdictWrongAnswer {
+		t.Errorf("unexpected test verdicts %s, %s", update.Tests[0].Verdict, update.Tests[1].Verdict)
+	}
+
+	if v, ok := models.ParseVerdict("time_limit_exceeded"); !ok || v != models.VerdictTimeLimit {
+		t.Errorf("verdict is not parsed by name")
+	}
+	if v, ok := models.ParseVerdict("6"); !ok || v != models.VerdictWrongAnswer {
+		t.Errorf("verdict is not parsed by code")
+	}
+	if _, ok := models.ParseVerdict("42"); ok {
+		t.Errorf("unknown verdict must not be parsed")
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	ss := &models.SolutionSend{
+		Tests:  make(models.InputTests, 1000),
+		Limits: models.TaskLimits{Time: 15000},
+	}
+	// a correct solution running close to the limit on every test
+	if d := checker.Deadline(ss); d < 1000*15*time.Second {
+		t.Errorf("deadline %v is shorter than running the tests takes", d)
+	}
+
+	small := checker.Deadline(&models.SolutionSend{Tests: make(models.InputTests, 2)})
+	custom := checker.Deadline(&models.SolutionSend{
+		Tests:   make(models.InputTests, 2),
+		Checker: models.TaskChecker{Mode: models.CheckerModeCustom},
+	})
+	if custom <= small {
+		t.Errorf("custom checker needs time to compile and to run")
+	}
+}
@@ -0,0 +1,43 @@
+package checker
+
+import (
+	"liokoredu/application/models"
+	"strconv"
+)
+
+// NormalizeVerdict maps an update of the checker protocol onto verdicts.
+// Intermediate updates get the verdict of their status, final ones with a
+// code the backend doesn't know about are turned into a judge error.
+func NormalizeVerdict(update *models.SolutionUpdate) {
+	switch update.Status {
+	case models.SolutionStatusQueued:
+		update.Code = models.VerdictPending
+		return
+	case models.SolutionStatusCompiling:
+		update.Code = models.VerdictCompiling
+		return
+	case models.SolutionStatusRunning:
+		update.Code = models.VerdictRunning
+		return
+	}
+
+	if !update.Code.Final() {
+		if update.CheckMessage == "" {
+			update.CheckMessage = "checker reported unexpected verdict " + strconv.Itoa(int(update.Code))
+		}
+		update.Code = models.VerdictJudgeError
+	}
+
+	for i := range update.Tests {
+		run := &update.Tests[i]
+		switch {
+		case run.Passed:
+			run.Verdict = models.VerdictAccepted
+		case run.Verdict.Final() && run.Verdict != models.VerdictAccepted:
+		case update.Code != models.VerdictAccepted:
+			run.Verdict = update.Code
+		default:
+			run.Verdict = models.VerdictWrongAnswer
+		}
+	}
+}
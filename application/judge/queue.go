@@ -0,0 +1,21 @@
+package judge
+
+import (
+	"liokoredu/application/models"
+	"time"
+)
+
+// Queue is a durable queue of solutions waiting to be judged.
+// A popped job stays reserved by the instance until it is acknowledged,
+// retried or moved to the dead letter queue. Instances keep their
+// reservations with Heartbeat, Requeue releases jobs of dead ones.
+type Queue interface {
+	Push(job *models.JudgeJob) error
+	Pop(timeout time.Duration) (*models.JudgeJob, error)
+	Ack(job *models.JudgeJob) error
+	Retry(job *models.JudgeJob, delay time.Duration) error
+	DeadLetter(job *models.JudgeJob) error
+	PromoteDelayed() error
+	Heartbeat() error
+	Requeue() error
+}
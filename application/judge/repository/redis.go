@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"liokoredu/application/judge"
+	"liokoredu/application/models"
+	"liokoredu/pkg/constants"
+	"liokoredu/pkg/generators"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/mailru/easyjson"
+)
+
+const (
+	queueKey   = "judge:queue"
+	delayedKey = "judge:delayed"
+	deadKey    = "judge:dead"
+	// every instance reserves jobs in its own processing list and holds a
+	// lease on it while alive, instances are registered in a set
+	instancesKey  = "judge:instances"
+	processingKey = "judge:processing:"
+	leaseKey      = "judge:lease:"
+)
+
+type JudgeQueue struct {
+	poolRedis *redis.Pool
+	// id of this instance
+	id string
+}
+
+func (jq *JudgeQueue) processing() string {
+	return processingKey + jq.id
+}
+
+func (jq *JudgeQueue) Push(job *models.JudgeJob) error {
+	client := jq.poolRedis.Get()
+	defer client.Close()
+
+	payload, err := easyjson.Marshal(job)
+	if err != nil {
+		log.Println("judge queue: Push: error marshaling job:", err)
+		return err
+	}
+
+	_, err = client.Do("LPUSH", queueKey, payload)
+	if err != nil {
+		log.Println("judge queue: Push: error pushing job:", err)
+		return err
+	}
+
+	return nil
+}
+
+// Pop waits for the next job and reserves it in the processing list.
+// It returns nil job when nothing arrived before timeout.
+func (jq *JudgeQueue) Pop(timeout time.Duration) (*models.JudgeJob, error) {
+	client := jq.poolRedis.Get()
+	defer client.Close()
+
+	payload, err := redis.Bytes(client.Do("BRPOPLPUSH", queueKey, jq.processing(), int(timeout.Seconds())))
+	if err == redis.ErrNil {
+		return nil, nil
+	}
+	if err != nil {
+		log.Println("judge queue: Pop: error popping job:", err)
+		return nil, err
+	}
+
+	job := &models.JudgeJob{}
+	if err = easyjson.Unmarshal(payload, job); err != nil {
+		log.Println("judge queue: Pop: broken job, moving to dead letters:", err)
+		_, _ = client.Do("LPUSH", deadKey, payload)
+		_, _ = client.Do("LREM", jq.processing(), 1, payload)
+		return nil, nil
+	}
+	job.Payload = payload
+
+	return job, nil
+}
+
+func (jq *JudgeQueue) Ack(job *models.JudgeJob) error {
+	client := jq.poolRedis.Get()
+	defer client.Close()
+
+	_, err := client.Do("LREM", jq.processing(), 1, job.Payload)
+	if err != nil {
+		log.Println("judge queue: Ack: error acknowledging job:", err)
+		return err
+	}
+
+	return nil
+}
+
+// Retry releases the job and schedules it again after delay.
+func (jq *JudgeQueue) Retry(job *models.JudgeJob, delay time.Duration) error {
+	client := jq.poolRedis.Get()
+	defer client.Close()
+
+	payload, err := easyjson.Marshal(job)
+	if err != nil {
+		log.Println("judge queue: Retry: error marshaling job:", err)
+		return err
+	}
+
+	readyAt := time.Now().Add(delay).Unix()
+	_, err = client.Do("ZADD", delayedKey, readyAt, payload)
+	if err != nil {
+		log.Println("judge queue: Retry: error scheduling job:", err)
+		return err
+	}
+
+	return jq.Ack(job)
+}
+
+func (jq *JudgeQueue) DeadLetter(job *models.JudgeJob) error {
+	client := jq.poolRedis.Get()
+	defer client.Close()
+
+	payload, err := easyjson.Marshal(job)
+	if err != nil {
+		log.Println("judge queue: DeadLetter: error marshaling job:", err)
+		return err
+	}
+
+	_, err = client.Do("LPUSH", deadKey, payload)
+	if err != nil {
+		log.Println("judge queue: DeadLetter: error storing job:", err)
+		return err
+	}
+
+	return jq.Ack(job)
+}
+
+// PromoteDelayed moves retried jobs whose delay has passed back to the queue.
+func (jq *JudgeQueue) PromoteDelayed() error {
+	client := jq.poolRedis.Get()
+	defer client.Close()
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	payloads, err := redis.ByteSlices(client.Do("ZRANGEBYSCORE", delayedKey, "-inf", now))
+	if err != nil {
+		log.Println("judge queue: PromoteDelayed: error getting delayed jobs:", err)
+		return err
+	}
+
+	for _, payload := range payloads {
+		// only the instance which removed the job pushes it back
+		removed, err := redis.Int(client.Do("ZREM", delayedKey, payload))
+		if err != nil {
+			log.Println("judge queue: PromoteDelayed: error removing delayed job:", err)
+			return err
+		}
+		if removed == 0 {
+			continue
+		}
+
+		if _, err = client.Do("LPUSH", queueKey, payload); err != nil {
+			log.Println("judge queue: PromoteDelayed: error pushing job:", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Heartbeat registers the instance and renews its lease, it must be called
+// more often than the lease expires.
+func (jq *JudgeQueue) Heartbeat() error {
+	client := jq.poolRedis.Get()
+	defer client.Close()
+
+	if _, err := client.Do("SADD", instancesKey, jq.id); err != nil {
+		log.Println("judge queue: Heartbeat: error registering instance:", err)
+		return err
+	}
+
+	ttl := int(constants.JudgeLeaseTTL.Seconds())
+	if _, err := client.Do("SET", leaseKey+jq.id, 1, "EX", ttl); err != nil {
+		log.Println("judge queue: Heartbeat: error renewing lease:", err)
+		return err
+	}
+
+	return nil
+}
+
+// Requeue returns jobs of instances whose lease has expired (e.g. after a
+// crash) back to the queue. Jobs of live instances are left to them.
+func (jq *JudgeQueue) Requeue() error {
+	client := jq.poolRedis.Get()
+	defer client.Close()
+
+	ids, err := redis.Strings(client.Do("SMEMBERS", instancesKey))
+	if err != nil {
+		log.Println("judge queue: Requeue: error getting instances:", err)
+		return err
+	}
+
+	for _, id := range ids {
+		alive, err := redis.Bool(client.Do("EXISTS", leaseKey+id))
+		if err != nil {
+			log.Println("judge queue: Requeue: error checking lease:", err)
+			return err
+		}
+		if alive {
+			continue
+		}
+
+		if err = jq.requeueList(client, processingKey+id); err != nil {
+			return err
+		}
+		if _, err = client.Do("SREM", instancesKey, id); err != nil {
+			log.Println("judge queue: Requeue: error removing instance:", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (jq *JudgeQueue) requeueList(client redis.Conn, list string) error {
+	for {
+		payload, err := redis.Bytes(client.Do("RPOPLPUSH", list, queueKey))
+		if err == redis.ErrNil {
+			return nil
+		}
+		if err != nil {
+			log.Println("judge queue: Requeue: error returning job:", err)
+			return err
+		}
+		log.Println("judge queue: Requeue: returned abandoned job", string(payload))
+	}
+}
+
+func NewJudgeQueue(poolRedis *redis.Pool) judge.Queue {
+	host, _ := os.Hostname()
+	return &JudgeQueue{
+		poolRedis: poolRedis,
+		id:        host + "-" + strconv.Itoa(os.Getpid()) + "-" + generators.RandStringRunes(8),
+	}
+}
@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"liokoredu/application/judge"
+	"liokoredu/application/models"
+	"liokoredu/application/solution"
+	"liokoredu/pkg/constants"
+	"log"
+	"time"
+)
+
+// Pool is a set of judge workers pulling jobs from the queue.
+type Pool struct {
+	queue   judge.Queue
+	suc     solution.UseCase
+	workers int
+}
+
+func NewPool(q judge.Queue, suc solution.UseCase, workers int) *Pool {
+	return &Pool{queue: q, suc: suc, workers: workers}
+}
+
+// Start recovers abandoned jobs and launches the workers in background.
+func (p *Pool) Start() {
+	if err := p.queue.Heartbeat(); err != nil {
+		log.Println("judge pool: Start: error registering instance:", err)
+	}
+	if err := p.queue.Requeue(); err != nil {
+		log.Println("judge pool: Start: error requeueing abandoned jobs:", err)
+	}
+
+	go p.heartbeat()
+	go p.promote()
+	go p.sweep()
+	for i := 0; i < p.workers; i++ {
+		go p.work(i)
+	}
+	log.Println("judge pool: started", p.workers, "workers")
+}
+
+func (p *Pool) promote() {
+	ticker := time.NewTicker(constants.JudgeRetryDelay)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		_ = p.queue.PromoteDelayed()
+	}
+}
+
+// heartbeat keeps the jobs of this instance reserved and takes over jobs
+// of instances which have died meanwhile.
+func (p *Pool) heartbeat() {
+	ticker := time.NewTicker(constants.JudgeHeartbeatInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := p.queue.Heartbeat(); err != nil {
+			continue
+		}
+		_ = p.queue.Requeue()
+	}
+}
+
+func (p *Pool) work(n int) {
+	for {
+		job, err := p.queue.Pop(constants.JudgePopTimeout)
+		if err != nil {
+			log.Println("judge worker", n, ": error getting job:", err)
+			time.Sleep(constants.JudgeRetryDelay)
+			continue
+		}
+		if job == nil {
+			continue
+		}
+
+		p.handle(n, job)
+	}
+}
+
+func (p *Pool) handle(n int, job *models.JudgeJob) {
+	err := p.suc.JudgeSolution(*job)
+	if err == nil {
+		_ = p.queue.Ack(job)
+		return
+	}
+
+	job.Attempts++
+	job.Error = err.Error()
+
+	if job.Attempts < constants.JudgeMaxAttempts {
+		log.Println("judge worker", n, ": solution", job.SolutionId, "failed, attempt", job.Attempts, ":", err)
+		_ = p.queue.Retry(job, time.Duration(job.Attempts)*constants.JudgeRetryDelay)
+		return
+	}
+
+	log.Println("judge worker", n, ": solution", job.SolutionId, "moved to dead letters:", err)
+	if err = p.queue.DeadLetter(job); err != nil {
+		return
+	}
+
+	_ = p.suc.AbandonSolution(*job)
+}
+
+// sweep looks for stale solutions, e.g. after tests of a task were changed.
+func (p *Pool) sweep() {
+	ticker := time.NewTicker(constants.RejudgeSweepInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := p.suc.RejudgeStale(); err != nil {
+			log.Println("judge pool: sweep: error queueing stale solutions:", err)
+		}
+	}
+}
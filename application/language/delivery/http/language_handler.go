@@ -0,0 +1,30 @@
+package http
+
+import (
+	"liokoredu/application/language"
+	"log"
+	"net/http"
+
+	"github.com/labstack/echo"
+	"github.com/mailru/easyjson"
+)
+
+type LanguageHandler struct{}
+
+func CreateLanguageHandler(e *echo.Echo) {
+	languageHandler := LanguageHandler{}
+	e.GET("/api/v1/languages", languageHandler.getLanguages)
+}
+
+func (lh LanguageHandler) getLanguages(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	langs := language.All()
+	if _, err := easyjson.MarshalToWriter(langs, c.Response().Writer); err != nil {
+		log.Println("language handler: getLanguages: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
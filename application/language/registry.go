@@ -0,0 +1,79 @@
+package language
+
+import (
+	"liokoredu/application/models"
+	"net/http"
+	"path"
+
+	"github.com/labstack/echo"
+)
+
+var registry = models.Languages{
+	{
+		Id:         "c",
+		Name:       "C (gcc)",
+		Compile:    "gcc -O2 -std=c11 -o solution *.c -lm",
+		Run:        "./solution",
+		Extensions: []string{".c", ".h"},
+	},
+	{
+		Id:         "cpp",
+		Name:       "C++ (g++)",
+		Compile:    "g++ -O2 -std=c++17 -o solution *.cpp",
+		Run:        "./solution",
+		Extensions: []string{".cpp", ".h", ".hpp"},
+	},
+	{
+		Id:         "python",
+		Name:       "Python 3",
+		Compile:    "python3 -m py_compile main.py",
+		Run:        "python3 main.py",
+		Extensions: []string{".py"},
+	},
+	{
+		Id:         "go",
+		Name:       "Go",
+		Compile:    "go build -o solution *.go",
+		Run:        "./solution",
+		Extensions: []string{".go"},
+	},
+}
+
+// All returns every known language.
+func All() models.Languages {
+	return registry
+}
+
+// Get finds a language by its id.
+func Get(id string) (models.Language, bool) {
+	for _, lang := range registry {
+		if lang.Id == id {
+			return lang, true
+		}
+	}
+	return models.Language{}, false
+}
+
+// CheckFiles makes sure the solution is made of files of the language.
+func CheckFiles(lang models.Language, code map[string]interface{}) error {
+	if len(code) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "solution has no files")
+	}
+
+	for name := range code {
+		if !hasExtension(lang, path.Ext(name)) {
+			return echo.NewHTTPError(http.StatusBadRequest, "file "+name+" is not a "+lang.Name+" file")
+		}
+	}
+
+	return nil
+}
+
+func hasExtension(lang models.Language, ext string) bool {
+	for _, e := range lang.Extensions {
+		if e == ext {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// TaskAccess shows who can see a private task. Code is the invite, it is
+// empty when the invite is revoked.
+type TaskAccess struct {
+	IsPrivate bool       `json:"isPrivate"`
+	Code      string     `json:"code"`
+	Grants    TaskGrants `json:"grants"`
+}
+
+type TaskAccessUpdate struct {
+	IsPrivate bool `json:"isPrivate"`
+}
+
+// TaskGrant allows the user to see a private task.
+type TaskGrant struct {
+	Uid      uint64    `json:"uid"`
+	Username string    `json:"username"`
+	Date     time.Time `json:"date"`
+}
+
+//easyjson:json
+type TaskGrants []TaskGrant
+
+type TaskGrantNew struct {
+	Username string `json:"username"`
+}
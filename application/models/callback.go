@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// CallbackAudit is a record about a rejected checker callback.
+type CallbackAudit struct {
+	SolutionId       uint64
+	RemoteAddr       string
+	Reason           string
+	Signature        string
+	Body             string
+	ReceivedDateTime time.Time
+}
@@ -0,0 +1,42 @@
+package models
+
+// Checker modes of a task, they define how program output is compared
+// with the expected one.
+const (
+	// byte to byte equality
+	CheckerModeExact = "exact"
+	// equal sequences of whitespace separated tokens
+	CheckerModeTokens = "tokens"
+	// equal lines up to trailing spaces, space runs and trailing empty lines
+	CheckerModeWhitespace = "whitespace"
+	// tokens, numbers are compared with absolute or relative epsilon
+	CheckerModeFloat = "float"
+	// equal sets of lines in any order
+	CheckerModeUnorderedLines = "unordered_lines"
+	// author's checker program decides
+	CheckerModeCustom = "custom"
+)
+
+// TaskChecker tells the judge how to check outputs of a task.
+// A custom checker program gets paths to the input, the expected and the
+// actual output as arguments and accepts the answer with zero exit code.
+type TaskChecker struct {
+	Mode       string                 `json:"mode"`
+	AbsEpsilon float64                `json:"absEpsilon,omitempty"`
+	RelEpsilon float64                `json:"relEpsilon,omitempty"`
+	Language   string                 `json:"language,omitempty"`
+	SourceCode map[string]interface{} `json:"sourceCode,omitempty"`
+}
+
+func (tc TaskChecker) Validate() bool {
+	switch tc.Mode {
+	case CheckerModeExact, CheckerModeTokens, CheckerModeWhitespace, CheckerModeUnorderedLines:
+		return true
+	case CheckerModeFloat:
+		return tc.AbsEpsilon >= 0 && tc.RelEpsilon >= 0 && (tc.AbsEpsilon > 0 || tc.RelEpsilon > 0)
+	case CheckerModeCustom:
+		return len(tc.SourceCode) != 0
+	}
+
+	return false
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"encoding/json"
+	"log"
+)
+
+// Program is a source code of an author's helper program.
+type Program struct {
+	Language   string                 `json:"language"`
+	SourceCode map[string]interface{} `json:"sourceCode"`
+}
+
+// GeneratorStep describes a single generated test. Args are given to the
+// generator on stdin, so the same seed always gives the same test.
+type GeneratorStep struct {
+	Args   string `json:"args"`
+	Sample bool   `json:"sample,omitempty"`
+	Hidden bool   `json:"hidden,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+//easyjson:json
+type GeneratorScript []GeneratorStep
+
+// TaskGenerator builds tests of a task. The generator prints an input for
+// each step of the script, the reference solution gives expected outputs.
+type TaskGenerator struct {
+	Generator Program         `json:"generator"`
+	Reference Program         `json:"reference"`
+	Script    GeneratorScript `json:"script"`
+}
+
+type TaskGeneratorSQL struct {
+	TaskId            uint64
+	GeneratorLanguage string
+	GeneratorCode     string
+	ReferenceLanguage string
+	ReferenceCode     string
+	Script            string
+}
+
+func (tg TaskGenerator) ConvertToSQL(taskId uint64) TaskGeneratorSQL {
+	gen, _ := json.Marshal(tg.Generator.SourceCode)
+	ref, _ := json.Marshal(tg.Reference.SourceCode)
+	script, _ := json.Marshal(tg.Script)
+	return TaskGeneratorSQL{
+		TaskId:            taskId,
+		GeneratorLanguage: tg.Generator.Language,
+		GeneratorCode:     string(gen),
+		ReferenceLanguage: tg.Reference.Language,
+		ReferenceCode:     string(ref),
+		Script:            string(script),
+	}
+}
+
+func (tgSQL TaskGeneratorSQL) ConvertToGenerator() *TaskGenerator {
+	tg := &TaskGenerator{
+		Generator: Program{Language: tgSQL.GeneratorLanguage},
+		Reference: Program{Language: tgSQL.ReferenceLanguage},
+		Script:    GeneratorScript{},
+	}
+	if err := json.Unmarshal([]byte(tgSQL.GeneratorCode), &tg.Generator.SourceCode); err != nil {
+		log.Println("error converting generator: ", err)
+	}
+	if err := json.Unmarshal([]byte(tgSQL.ReferenceCode), &tg.Reference.SourceCode); err != nil {
+		log.Println("error converting reference solution: ", err)
+	}
+	if err := json.Unmarshal([]byte(tgSQL.Script), &tg.Script); err != nil {
+		log.Println("error converting generator script: ", err)
+	}
+	return tg
+}
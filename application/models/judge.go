@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// JudgeJob is a queued request to check a solution.
+type JudgeJob struct {
+	SolutionId uint64 `json:"solutionId"`
+	TaskId     uint64 `json:"taskId"`
+	Uid        uint64 `json:"uid"`
+	Attempts   int    `json:"attempts"`
+	Rejudge    bool   `json:"rejudge,omitempty"`
+	Error      string `json:"error,omitempty"`
+
+	// Payload keeps the exact queue entry the job was read from,
+	// it is needed to acknowledge the job.
+	Payload []byte `json:"-"`
+}
+
+// RejudgeStatus is a progress of rechecking solutions of a task.
+type RejudgeStatus struct {
+	TaskId     uint64    `json:"taskId"`
+	Total      int       `json:"total"`
+	Done       int       `json:"done"`
+	Pending    int       `json:"pending"`
+	StartedAt  time.Time `json:"startedAt"`
+	InProgress bool      `json:"inProgress"`
+}
@@ -0,0 +1,14 @@
+package models
+
+// Language is a toolchain solutions can be written with. Commands are run
+// by the judge in the directory with the solution files.
+type Language struct {
+	Id         string   `json:"id"`
+	Name       string   `json:"name"`
+	Compile    string   `json:"compile"`
+	Run        string   `json:"run"`
+	Extensions []string `json:"extensions"`
+}
+
+//easyjson:json
+type Languages []Language
@@ -0,0 +1,39 @@
+package models
+
+import "liokoredu/pkg/constants"
+
+// TaskLimits bound resources of a single test run: time in
+// milliseconds, memory and output size in kilobytes.
+type TaskLimits struct {
+	Time   int `json:"time"`
+	Memory int `json:"memory"`
+	Output int `json:"output"`
+}
+
+// WithDefaults fills limits the author did not set.
+func (tl TaskLimits) WithDefaults() TaskLimits {
+	if tl.Time == 0 {
+		tl.Time = constants.TaskTimeLimit
+	}
+	if tl.Memory == 0 {
+		tl.Memory = constants.TaskMemoryLimit
+	}
+	if tl.Output == 0 {
+		tl.Output = constants.TaskOutputLimit
+	}
+	return tl
+}
+
+func (tl TaskLimits) Validate() bool {
+	if tl.Time < 0 || tl.Time > constants.TaskMaxTimeLimit {
+		return false
+	}
+	if tl.Memory < 0 || tl.Memory > constants.TaskMaxMemoryLimit {
+		return false
+	}
+	if tl.Output < 0 || tl.Output > constants.TaskMaxOutputLimit {
+		return false
+	}
+
+	return true
+}
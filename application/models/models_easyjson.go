@@ -8,6 +8,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -18,7 +19,192 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels(in *jlexer.Lexer, out *Users) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels(in *jlexer.Lexer, out *taskTestObject) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "stdin":
+			out.Stdin = string(in.String())
+		case "stdout":
+			out.Stdout = string(in.String())
+		case "sample":
+			out.Sample = bool(in.Bool())
+		case "hidden":
+			out.Hidden = bool(in.Bool())
+		case "note":
+			out.Note = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels(out *jwriter.Writer, in taskTestObject) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"stdin\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Stdin))
+	}
+	{
+		const prefix string = ",\"stdout\":"
+		out.RawString(prefix)
+		out.String(string(in.Stdout))
+	}
+	if in.Sample {
+		const prefix string = ",\"sample\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Sample))
+	}
+	if in.Hidden {
+		const prefix string = ",\"hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Hidden))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v taskTestObject) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v taskTestObject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *taskTestObject) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *taskTestObject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels1(in *jlexer.Lexer, out *taskTestFlags) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sample":
+			out.Sample = bool(in.Bool())
+		case "hidden":
+			out.Hidden = bool(in.Bool())
+		case "note":
+			out.Note = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels1(out *jwriter.Writer, in taskTestFlags) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Sample {
+		const prefix string = ",\"sample\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Sample))
+	}
+	if in.Hidden {
+		const prefix string = ",\"hidden\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Bool(bool(in.Hidden))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v taskTestFlags) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v taskTestFlags) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *taskTestFlags) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *taskTestFlags) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels1(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels2(in *jlexer.Lexer, out *Users) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -46,7 +232,7 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels(in *jlexer.Lexer, out *Use
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels(out *jwriter.Writer, in Users) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels2(out *jwriter.Writer, in Users) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
@@ -64,27 +250,27 @@ func easyjsonD2b7633eEncodeLiokoreduApplicationModels(out *jwriter.Writer, in Us
 // MarshalJSON supports json.Marshaler interface
 func (v Users) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Users) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels(w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Users) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Users) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels(l, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels2(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels1(in *jlexer.Lexer, out *UserUpdate) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels3(in *jlexer.Lexer, out *UserUpdate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -117,7 +303,7 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels1(in *jlexer.Lexer, out *Us
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels1(out *jwriter.Writer, in UserUpdate) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels3(out *jwriter.Writer, in UserUpdate) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -137,27 +323,27 @@ func easyjsonD2b7633eEncodeLiokoreduApplicationModels1(out *jwriter.Writer, in U
 // MarshalJSON supports json.Marshaler interface
 func (v UserUpdate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels1(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserUpdate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels1(w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserUpdate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels1(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels1(l, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels3(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels2(in *jlexer.Lexer, out *UserAuth) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels4(in *jlexer.Lexer, out *UserAuth) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -192,7 +378,7 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels2(in *jlexer.Lexer, out *Us
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels2(out *jwriter.Writer, in UserAuth) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels4(out *jwriter.Writer, in UserAuth) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -217,27 +403,27 @@ func easyjsonD2b7633eEncodeLiokoreduApplicationModels2(out *jwriter.Writer, in U
 // MarshalJSON supports json.Marshaler interface
 func (v UserAuth) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels2(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserAuth) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels2(w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserAuth) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels2(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserAuth) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels2(l, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels4(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels3(in *jlexer.Lexer, out *User) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels5(in *jlexer.Lexer, out *User) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -288,7 +474,7 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels3(in *jlexer.Lexer, out *Us
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels3(out *jwriter.Writer, in User) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels5(out *jwriter.Writer, in User) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -348,27 +534,27 @@ func easyjsonD2b7633eEncodeLiokoreduApplicationModels3(out *jwriter.Writer, in U
 // MarshalJSON supports json.Marshaler interface
 func (v User) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels3(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v User) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels3(w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *User) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels3(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels3(l, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels5(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels4(in *jlexer.Lexer, out *TestResults) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels6(in *jlexer.Lexer, out *TestRuns) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -377,15 +563,15 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels4(in *jlexer.Lexer, out *Te
 		in.Delim('[')
 		if *out == nil {
 			if !in.IsDelim(']') {
-				*out = make(TestResults, 0, 1)
+				*out = make(TestRuns, 0, 1)
 			} else {
-				*out = TestResults{}
+				*out = TestRuns{}
 			}
 		} else {
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v4 TestResult
+			var v4 TestRun
 			(v4).UnmarshalEasyJSON(in)
 			*out = append(*out, v4)
 			in.WantComma()
@@ -396,7 +582,7 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels4(in *jlexer.Lexer, out *Te
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels4(out *jwriter.Writer, in TestResults) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels6(out *jwriter.Writer, in TestRuns) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
@@ -412,29 +598,29 @@ func easyjsonD2b7633eEncodeLiokoreduApplicationModels4(out *jwriter.Writer, in T
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TestResults) MarshalJSON() ([]byte, error) {
+func (v TestRuns) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels4(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TestResults) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels4(w, v)
+func (v TestRuns) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TestResults) UnmarshalJSON(data []byte) error {
+func (v *TestRuns) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels4(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TestResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels4(l, v)
+func (v *TestRuns) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels6(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels5(in *jlexer.Lexer, out *TestResult) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels7(in *jlexer.Lexer, out *TestRun) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -453,12 +639,18 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels5(in *jlexer.Lexer, out *Te
 			continue
 		}
 		switch key {
-		case "stdin":
-			out.Stdin = string(in.String())
-		case "stdout":
-			out.Stdout = string(in.String())
 		case "passed":
 			out.Passed = bool(in.Bool())
+		case "checkResult":
+			out.Verdict = Verdict(in.Int())
+		case "stdout":
+			out.Stdout = string(in.String())
+		case "stderr":
+			out.Stderr = string(in.String())
+		case "time":
+			out.RunTime = float32(in.Float32())
+		case "memory":
+			out.Memory = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -469,14 +661,19 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels5(in *jlexer.Lexer, out *Te
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels5(out *jwriter.Writer, in TestResult) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels7(out *jwriter.Writer, in TestRun) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"stdin\":"
+		const prefix string = ",\"passed\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Stdin))
+		out.Bool(bool(in.Passed))
+	}
+	{
+		const prefix string = ",\"checkResult\":"
+		out.RawString(prefix)
+		out.Int(int(in.Verdict))
 	}
 	{
 		const prefix string = ",\"stdout\":"
@@ -484,138 +681,75 @@ func easyjsonD2b7633eEncodeLiokoreduApplicationModels5(out *jwriter.Writer, in T
 		out.String(string(in.Stdout))
 	}
 	{
-		const prefix string = ",\"passed\":"
+		const prefix string = ",\"stderr\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Passed))
+		out.String(string(in.Stderr))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.RunTime))
+	}
+	{
+		const prefix string = ",\"memory\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Memory))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TestResult) MarshalJSON() ([]byte, error) {
+func (v TestRun) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels5(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TestResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels5(w, v)
+func (v TestRun) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TestResult) UnmarshalJSON(data []byte) error {
+func (v *TestRun) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels5(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TestResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels5(l, v)
+func (v *TestRun) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels7(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels6(in *jlexer.Lexer, out *TasksWithNum) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels8(in *jlexer.Lexer, out *TestResults) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
 		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TestResults, 0, 0)
+			} else {
+				*out = TestResults{}
+			}
+		} else {
+			*out = (*out)[:0]
 		}
-		switch key {
-		case "tasks":
-			(out.Tsks).UnmarshalEasyJSON(in)
-		case "num":
-			out.Num = int(in.Int())
-		default:
-			in.SkipRecursive()
+		for !in.IsDelim(']') {
+			var v7 TestResult
+			(v7).UnmarshalEasyJSON(in)
+			*out = append(*out, v7)
+			in.WantComma()
 		}
-		in.WantComma()
+		in.Delim(']')
 	}
-	in.Delim('}')
 	if isTopLevel {
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels6(out *jwriter.Writer, in TasksWithNum) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"tasks\":"
-		out.RawString(prefix[1:])
-		(in.Tsks).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"num\":"
-		out.RawString(prefix)
-		out.Int(int(in.Num))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v TasksWithNum) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels6(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TasksWithNum) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels6(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *TasksWithNum) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels6(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TasksWithNum) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels6(l, v)
-}
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels7(in *jlexer.Lexer, out *TasksSQL) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		in.Skip()
-		*out = nil
-	} else {
-		in.Delim('[')
-		if *out == nil {
-			if !in.IsDelim(']') {
-				*out = make(TasksSQL, 0, 0)
-			} else {
-				*out = TasksSQL{}
-			}
-		} else {
-			*out = (*out)[:0]
-		}
-		for !in.IsDelim(']') {
-			var v7 TaskSQL
-			(v7).UnmarshalEasyJSON(in)
-			*out = append(*out, v7)
-			in.WantComma()
-		}
-		in.Delim(']')
-	}
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels7(out *jwriter.Writer, in TasksSQL) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels8(out *jwriter.Writer, in TestResults) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
@@ -631,95 +765,29 @@ func easyjsonD2b7633eEncodeLiokoreduApplicationModels7(out *jwriter.Writer, in T
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TasksSQL) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels7(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TasksSQL) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels7(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *TasksSQL) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels7(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TasksSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels7(l, v)
-}
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels8(in *jlexer.Lexer, out *Tasks) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		in.Skip()
-		*out = nil
-	} else {
-		in.Delim('[')
-		if *out == nil {
-			if !in.IsDelim(']') {
-				*out = make(Tasks, 0, 0)
-			} else {
-				*out = Tasks{}
-			}
-		} else {
-			*out = (*out)[:0]
-		}
-		for !in.IsDelim(']') {
-			var v10 Task
-			(v10).UnmarshalEasyJSON(in)
-			*out = append(*out, v10)
-			in.WantComma()
-		}
-		in.Delim(']')
-	}
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels8(out *jwriter.Writer, in Tasks) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v11, v12 := range in {
-			if v11 > 0 {
-				out.RawByte(',')
-			}
-			(v12).MarshalEasyJSON(out)
-		}
-		out.RawByte(']')
-	}
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Tasks) MarshalJSON() ([]byte, error) {
+func (v TestResults) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeLiokoreduApplicationModels8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Tasks) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TestResults) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeLiokoreduApplicationModels8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Tasks) UnmarshalJSON(data []byte) error {
+func (v *TestResults) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeLiokoreduApplicationModels8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Tasks) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TestResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeLiokoreduApplicationModels8(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels9(in *jlexer.Lexer, out *TaskSQL) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels9(in *jlexer.Lexer, out *TestResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -738,32 +806,28 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels9(in *jlexer.Lexer, out *Ta
 			continue
 		}
 		switch key {
-		case "Id":
-			out.Id = uint64(in.Uint64())
-		case "Title":
-			out.Title = string(in.String())
-		case "Description":
-			out.Description = string(in.String())
-		case "Hints":
-			easyjsonD2b7633eDecodeDatabaseSql(in, &out.Hints)
-		case "Input":
-			out.Input = string(in.String())
-		case "Output":
-			out.Output = string(in.String())
-		case "TestAmount":
-			out.TestAmount = int(in.Int())
-		case "Tests":
-			out.Tests = string(in.String())
-		case "Creator":
-			out.Creator = uint64(in.Uint64())
-		case "IsPrivate":
-			out.IsPrivate = bool(in.Bool())
-		case "Code":
-			easyjsonD2b7633eDecodeDatabaseSql(in, &out.Code)
-		case "Date":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Date).UnmarshalJSON(data))
-			}
+		case "sample":
+			out.Sample = bool(in.Bool())
+		case "hidden":
+			out.Hidden = bool(in.Bool())
+		case "note":
+			out.Note = string(in.String())
+		case "stdin":
+			out.Stdin = string(in.String())
+		case "expectedStdout":
+			out.ExpectedStdout = string(in.String())
+		case "stdout":
+			out.Stdout = string(in.String())
+		case "stderr":
+			out.Stderr = string(in.String())
+		case "passed":
+			out.Passed = bool(in.Bool())
+		case "checkResult":
+			out.Verdict = Verdict(in.Int())
+		case "time":
+			out.RunTime = float32(in.Float32())
+		case "memory":
+			out.Memory = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -774,97 +838,92 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels9(in *jlexer.Lexer, out *Ta
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels9(out *jwriter.Writer, in TaskSQL) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels9(out *jwriter.Writer, in TestResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Id\":"
+		const prefix string = ",\"sample\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Id))
-	}
-	{
-		const prefix string = ",\"Title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
+		out.Bool(bool(in.Sample))
 	}
 	{
-		const prefix string = ",\"Description\":"
+		const prefix string = ",\"hidden\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Bool(bool(in.Hidden))
 	}
-	{
-		const prefix string = ",\"Hints\":"
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
 		out.RawString(prefix)
-		easyjsonD2b7633eEncodeDatabaseSql(out, in.Hints)
+		out.String(string(in.Note))
 	}
 	{
-		const prefix string = ",\"Input\":"
+		const prefix string = ",\"stdin\":"
 		out.RawString(prefix)
-		out.String(string(in.Input))
+		out.String(string(in.Stdin))
 	}
 	{
-		const prefix string = ",\"Output\":"
+		const prefix string = ",\"expectedStdout\":"
 		out.RawString(prefix)
-		out.String(string(in.Output))
+		out.String(string(in.ExpectedStdout))
 	}
 	{
-		const prefix string = ",\"TestAmount\":"
+		const prefix string = ",\"stdout\":"
 		out.RawString(prefix)
-		out.Int(int(in.TestAmount))
+		out.String(string(in.Stdout))
 	}
 	{
-		const prefix string = ",\"Tests\":"
+		const prefix string = ",\"stderr\":"
 		out.RawString(prefix)
-		out.String(string(in.Tests))
+		out.String(string(in.Stderr))
 	}
 	{
-		const prefix string = ",\"Creator\":"
+		const prefix string = ",\"passed\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Creator))
+		out.Bool(bool(in.Passed))
 	}
 	{
-		const prefix string = ",\"IsPrivate\":"
+		const prefix string = ",\"checkResult\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsPrivate))
+		out.Int(int(in.Verdict))
 	}
 	{
-		const prefix string = ",\"Code\":"
+		const prefix string = ",\"time\":"
 		out.RawString(prefix)
-		easyjsonD2b7633eEncodeDatabaseSql(out, in.Code)
+		out.Float32(float32(in.RunTime))
 	}
 	{
-		const prefix string = ",\"Date\":"
+		const prefix string = ",\"memory\":"
 		out.RawString(prefix)
-		out.Raw((in.Date).MarshalJSON())
+		out.Int64(int64(in.Memory))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TaskSQL) MarshalJSON() ([]byte, error) {
+func (v TestResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonD2b7633eEncodeLiokoreduApplicationModels9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TaskSQL) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TestResult) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonD2b7633eEncodeLiokoreduApplicationModels9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TaskSQL) UnmarshalJSON(data []byte) error {
+func (v *TestResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonD2b7633eDecodeLiokoreduApplicationModels9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TaskSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TestResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeLiokoreduApplicationModels9(l, v)
 }
-func easyjsonD2b7633eDecodeDatabaseSql(in *jlexer.Lexer, out *sql.NullString) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels10(in *jlexer.Lexer, out *TasksWithNum) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -883,10 +942,20 @@ func easyjsonD2b7633eDecodeDatabaseSql(in *jlexer.Lexer, out *sql.NullString) {
 			continue
 		}
 		switch key {
-		case "String":
-			out.String = string(in.String())
-		case "Valid":
-			out.Valid = bool(in.Bool())
+		case "tasks":
+			(out.Tsks).UnmarshalEasyJSON(in)
+		case "num":
+			out.Num = int(in.Int())
+		case "facets":
+			if in.IsNull() {
+				in.Skip()
+				out.Facets = nil
+			} else {
+				if out.Facets == nil {
+					out.Facets = new(TaskFacets)
+				}
+				(*out.Facets).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -897,23 +966,6835 @@ func easyjsonD2b7633eDecodeDatabaseSql(in *jlexer.Lexer, out *sql.NullString) {
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeDatabaseSql(out *jwriter.Writer, in sql.NullString) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels10(out *jwriter.Writer, in TasksWithNum) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"String\":"
+		const prefix string = ",\"tasks\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.String))
+		(in.Tsks).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"Valid\":"
+		const prefix string = ",\"num\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Valid))
+		out.Int(int(in.Num))
+	}
+	if in.Facets != nil {
+		const prefix string = ",\"facets\":"
+		out.RawString(prefix)
+		(*in.Facets).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels10(in *jlexer.Lexer, out *TaskNew) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v TasksWithNum) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TasksWithNum) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TasksWithNum) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TasksWithNum) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels10(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels11(in *jlexer.Lexer, out *TasksSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TasksSQL, 0, 0)
+			} else {
+				*out = TasksSQL{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v10 TaskSQL
+			(v10).UnmarshalEasyJSON(in)
+			*out = append(*out, v10)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels11(out *jwriter.Writer, in TasksSQL) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v11, v12 := range in {
+			if v11 > 0 {
+				out.RawByte(',')
+			}
+			(v12).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TasksSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TasksSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TasksSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TasksSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels11(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels12(in *jlexer.Lexer, out *Tasks) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(Tasks, 0, 0)
+			} else {
+				*out = Tasks{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v13 Task
+			(v13).UnmarshalEasyJSON(in)
+			*out = append(*out, v13)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels12(out *jwriter.Writer, in Tasks) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v14, v15 := range in {
+			if v14 > 0 {
+				out.RawByte(',')
+			}
+			(v15).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Tasks) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Tasks) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Tasks) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Tasks) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels12(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels13(in *jlexer.Lexer, out *TaskTestsSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TaskTestsSQL, 0, 0)
+			} else {
+				*out = TaskTestsSQL{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v16 TaskTestSQL
+			(v16).UnmarshalEasyJSON(in)
+			*out = append(*out, v16)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels13(out *jwriter.Writer, in TaskTestsSQL) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v17, v18 := range in {
+			if v17 > 0 {
+				out.RawByte(',')
+			}
+			(v18).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskTestsSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskTestsSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskTestsSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskTestsSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels13(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels14(in *jlexer.Lexer, out *TaskTests) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TaskTests, 0, 1)
+			} else {
+				*out = TaskTests{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v19 TaskTest
+			if data := in.Raw(); in.Ok() {
+				in.AddError((v19).UnmarshalJSON(data))
+			}
+			*out = append(*out, v19)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels14(out *jwriter.Writer, in TaskTests) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v20, v21 := range in {
+			if v20 > 0 {
+				out.RawByte(',')
+			}
+			out.Raw((v21).MarshalJSON())
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskTests) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskTests) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskTests) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskTests) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels14(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels15(in *jlexer.Lexer, out *TaskTestSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			out.Id = uint64(in.Uint64())
+		case "TaskId":
+			out.TaskId = uint64(in.Uint64())
+		case "TestNum":
+			out.TestNum = int(in.Int())
+		case "InputKey":
+			out.InputKey = string(in.String())
+		case "OutputKey":
+			out.OutputKey = string(in.String())
+		case "InputSize":
+			out.InputSize = int(in.Int())
+		case "OutputSize":
+			out.OutputSize = int(in.Int())
+		case "Sample":
+			out.Sample = bool(in.Bool())
+		case "Hidden":
+			out.Hidden = bool(in.Bool())
+		case "Note":
+			out.Note = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels15(out *jwriter.Writer, in TaskTestSQL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"TaskId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskId))
+	}
+	{
+		const prefix string = ",\"TestNum\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestNum))
+	}
+	{
+		const prefix string = ",\"InputKey\":"
+		out.RawString(prefix)
+		out.String(string(in.InputKey))
+	}
+	{
+		const prefix string = ",\"OutputKey\":"
+		out.RawString(prefix)
+		out.String(string(in.OutputKey))
+	}
+	{
+		const prefix string = ",\"InputSize\":"
+		out.RawString(prefix)
+		out.Int(int(in.InputSize))
+	}
+	{
+		const prefix string = ",\"OutputSize\":"
+		out.RawString(prefix)
+		out.Int(int(in.OutputSize))
+	}
+	{
+		const prefix string = ",\"Sample\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Sample))
+	}
+	{
+		const prefix string = ",\"Hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Hidden))
+	}
+	{
+		const prefix string = ",\"Note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskTestSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskTestSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskTestSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskTestSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels15(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels16(in *jlexer.Lexer, out *TaskTestInfos) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TaskTestInfos, 0, 1)
+			} else {
+				*out = TaskTestInfos{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v22 TaskTestInfo
+			(v22).UnmarshalEasyJSON(in)
+			*out = append(*out, v22)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels16(out *jwriter.Writer, in TaskTestInfos) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v23, v24 := range in {
+			if v23 > 0 {
+				out.RawByte(',')
+			}
+			(v24).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskTestInfos) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskTestInfos) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskTestInfos) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskTestInfos) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels16(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels17(in *jlexer.Lexer, out *TaskTestInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "num":
+			out.Num = int(in.Int())
+		case "inputSize":
+			out.InputSize = int(in.Int())
+		case "outputSize":
+			out.OutputSize = int(in.Int())
+		case "sample":
+			out.Sample = bool(in.Bool())
+		case "hidden":
+			out.Hidden = bool(in.Bool())
+		case "note":
+			out.Note = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels17(out *jwriter.Writer, in TaskTestInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"num\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Num))
+	}
+	{
+		const prefix string = ",\"inputSize\":"
+		out.RawString(prefix)
+		out.Int(int(in.InputSize))
+	}
+	{
+		const prefix string = ",\"outputSize\":"
+		out.RawString(prefix)
+		out.Int(int(in.OutputSize))
+	}
+	{
+		const prefix string = ",\"sample\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Sample))
+	}
+	{
+		const prefix string = ",\"hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Hidden))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskTestInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskTestInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskTestInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskTestInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels17(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels18(in *jlexer.Lexer, out *TaskSubtasks) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TaskSubtasks, 0, 0)
+			} else {
+				*out = TaskSubtasks{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v25 TaskSubtask
+			(v25).UnmarshalEasyJSON(in)
+			*out = append(*out, v25)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels18(out *jwriter.Writer, in TaskSubtasks) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v26, v27 := range in {
+			if v26 > 0 {
+				out.RawByte(',')
+			}
+			(v27).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskSubtasks) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskSubtasks) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskSubtasks) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskSubtasks) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels18(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels19(in *jlexer.Lexer, out *TaskSubtask) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "points":
+			out.Points = int(in.Int())
+		case "tests":
+			if in.IsNull() {
+				in.Skip()
+				out.Tests = nil
+			} else {
+				in.Delim('[')
+				if out.Tests == nil {
+					if !in.IsDelim(']') {
+						out.Tests = make([]int, 0, 8)
+					} else {
+						out.Tests = []int{}
+					}
+				} else {
+					out.Tests = (out.Tests)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 int
+					v28 = int(in.Int())
+					out.Tests = append(out.Tests, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "depends":
+			if in.IsNull() {
+				in.Skip()
+				out.Depends = nil
+			} else {
+				in.Delim('[')
+				if out.Depends == nil {
+					if !in.IsDelim(']') {
+						out.Depends = make([]int, 0, 8)
+					} else {
+						out.Depends = []int{}
+					}
+				} else {
+					out.Depends = (out.Depends)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v29 int
+					v29 = int(in.Int())
+					out.Depends = append(out.Depends, v29)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels19(out *jwriter.Writer, in TaskSubtask) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"points\":"
+		out.RawString(prefix)
+		out.Int(int(in.Points))
+	}
+	{
+		const prefix string = ",\"tests\":"
+		out.RawString(prefix)
+		if in.Tests == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v30, v31 := range in.Tests {
+				if v30 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v31))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Depends) != 0 {
+		const prefix string = ",\"depends\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v32, v33 := range in.Depends {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				out.Int(int(v33))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskSubtask) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskSubtask) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskSubtask) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskSubtask) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels19(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels20(in *jlexer.Lexer, out *TaskSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			out.Id = uint64(in.Uint64())
+		case "Title":
+			out.Title = string(in.String())
+		case "Description":
+			out.Description = string(in.String())
+		case "Hints":
+			easyjsonD2b7633eDecodeDatabaseSql(in, &out.Hints)
+		case "Input":
+			out.Input = string(in.String())
+		case "Output":
+			out.Output = string(in.String())
+		case "TestAmount":
+			out.TestAmount = int(in.Int())
+		case "Tests":
+			out.Tests = string(in.String())
+		case "TestsKey":
+			out.TestsKey = string(in.String())
+		case "Subtasks":
+			out.Subtasks = string(in.String())
+		case "MaxScore":
+			out.MaxScore = int(in.Int())
+		case "Difficulty":
+			out.Difficulty = int(in.Int())
+		case "Revision":
+			out.Revision = int(in.Int())
+		case "Checker":
+			out.Checker = string(in.String())
+		case "TimeLimit":
+			out.TimeLimit = int(in.Int())
+		case "MemoryLimit":
+			out.MemoryLimit = int(in.Int())
+		case "OutputLimit":
+			out.OutputLimit = int(in.Int())
+		case "AllowedLanguages":
+			if in.IsNull() {
+				in.Skip()
+				out.AllowedLanguages = nil
+			} else {
+				in.Delim('[')
+				if out.AllowedLanguages == nil {
+					if !in.IsDelim(']') {
+						out.AllowedLanguages = make([]string, 0, 4)
+					} else {
+						out.AllowedLanguages = []string{}
+					}
+				} else {
+					out.AllowedLanguages = (out.AllowedLanguages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 string
+					v34 = string(in.String())
+					out.AllowedLanguages = append(out.AllowedLanguages, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Creator":
+			out.Creator = uint64(in.Uint64())
+		case "IsPrivate":
+			out.IsPrivate = bool(in.Bool())
+		case "Code":
+			easyjsonD2b7633eDecodeDatabaseSql(in, &out.Code)
+		case "Date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels20(out *jwriter.Writer, in TaskSQL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"Title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"Description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"Hints\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeDatabaseSql(out, in.Hints)
+	}
+	{
+		const prefix string = ",\"Input\":"
+		out.RawString(prefix)
+		out.String(string(in.Input))
+	}
+	{
+		const prefix string = ",\"Output\":"
+		out.RawString(prefix)
+		out.String(string(in.Output))
+	}
+	{
+		const prefix string = ",\"TestAmount\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestAmount))
+	}
+	{
+		const prefix string = ",\"Tests\":"
+		out.RawString(prefix)
+		out.String(string(in.Tests))
+	}
+	{
+		const prefix string = ",\"TestsKey\":"
+		out.RawString(prefix)
+		out.String(string(in.TestsKey))
+	}
+	{
+		const prefix string = ",\"Subtasks\":"
+		out.RawString(prefix)
+		out.String(string(in.Subtasks))
+	}
+	{
+		const prefix string = ",\"MaxScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxScore))
+	}
+	{
+		const prefix string = ",\"Difficulty\":"
+		out.RawString(prefix)
+		out.Int(int(in.Difficulty))
+	}
+	{
+		const prefix string = ",\"Revision\":"
+		out.RawString(prefix)
+		out.Int(int(in.Revision))
+	}
+	{
+		const prefix string = ",\"Checker\":"
+		out.RawString(prefix)
+		out.String(string(in.Checker))
+	}
+	{
+		const prefix string = ",\"TimeLimit\":"
+		out.RawString(prefix)
+		out.Int(int(in.TimeLimit))
+	}
+	{
+		const prefix string = ",\"MemoryLimit\":"
+		out.RawString(prefix)
+		out.Int(int(in.MemoryLimit))
+	}
+	{
+		const prefix string = ",\"OutputLimit\":"
+		out.RawString(prefix)
+		out.Int(int(in.OutputLimit))
+	}
+	{
+		const prefix string = ",\"AllowedLanguages\":"
+		out.RawString(prefix)
+		if in.AllowedLanguages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.AllowedLanguages {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v36))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Creator\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Creator))
+	}
+	{
+		const prefix string = ",\"IsPrivate\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrivate))
+	}
+	{
+		const prefix string = ",\"Code\":"
+		out.RawString(prefix)
+		easyjsonD2b7633eEncodeDatabaseSql(out, in.Code)
+	}
+	{
+		const prefix string = ",\"Date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels20(l, v)
+}
+func easyjsonD2b7633eDecodeDatabaseSql(in *jlexer.Lexer, out *sql.NullString) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "String":
+			out.String = string(in.String())
+		case "Valid":
+			out.Valid = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeDatabaseSql(out *jwriter.Writer, in sql.NullString) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"String\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.String))
+	}
+	{
+		const prefix string = ",\"Valid\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Valid))
+	}
+	out.RawByte('}')
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels21(in *jlexer.Lexer, out *TaskRevisionsSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TaskRevisionsSQL, 0, 0)
+			} else {
+				*out = TaskRevisionsSQL{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v37 TaskRevisionSQL
+			(v37).UnmarshalEasyJSON(in)
+			*out = append(*out, v37)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels21(out *jwriter.Writer, in TaskRevisionsSQL) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v38, v39 := range in {
+			if v38 > 0 {
+				out.RawByte(',')
+			}
+			(v39).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskRevisionsSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskRevisionsSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskRevisionsSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskRevisionsSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels21(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels22(in *jlexer.Lexer, out *TaskRevisions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TaskRevisions, 0, 0)
+			} else {
+				*out = TaskRevisions{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v40 TaskRevision
+			(v40).UnmarshalEasyJSON(in)
+			*out = append(*out, v40)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels22(out *jwriter.Writer, in TaskRevisions) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v41, v42 := range in {
+			if v41 > 0 {
+				out.RawByte(',')
+			}
+			(v42).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskRevisions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskRevisions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskRevisions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskRevisions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels22(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels23(in *jlexer.Lexer, out *TaskRevisionSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Revision":
+			out.Revision = int(in.Int())
+		case "Title":
+			out.Title = string(in.String())
+		case "AuthorId":
+			out.AuthorId = uint64(in.Uint64())
+		case "Author":
+			out.Author = string(in.String())
+		case "Date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels23(out *jwriter.Writer, in TaskRevisionSQL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Revision\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Revision))
+	}
+	{
+		const prefix string = ",\"Title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"AuthorId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.AuthorId))
+	}
+	{
+		const prefix string = ",\"Author\":"
+		out.RawString(prefix)
+		out.String(string(in.Author))
+	}
+	{
+		const prefix string = ",\"Date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskRevisionSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskRevisionSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskRevisionSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskRevisionSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels23(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels24(in *jlexer.Lexer, out *TaskRevisionDiff) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "from":
+			out.From = int(in.Int())
+		case "to":
+			out.To = int(in.Int())
+		case "files":
+			(out.Files).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels24(out *jwriter.Writer, in TaskRevisionDiff) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.From))
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.Int(int(in.To))
+	}
+	{
+		const prefix string = ",\"files\":"
+		out.RawString(prefix)
+		(in.Files).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskRevisionDiff) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskRevisionDiff) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskRevisionDiff) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskRevisionDiff) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels24(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels25(in *jlexer.Lexer, out *TaskRevision) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "revision":
+			out.Revision = int(in.Int())
+		case "name":
+			out.Title = string(in.String())
+		case "authorId":
+			out.AuthorId = uint64(in.Uint64())
+		case "author":
+			out.Author = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels25(out *jwriter.Writer, in TaskRevision) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"revision\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Revision))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"authorId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.AuthorId))
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		out.String(string(in.Author))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskRevision) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskRevision) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskRevision) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskRevision) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels25(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels26(in *jlexer.Lexer, out *TaskNew) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "stdinDescription":
+			out.Input = string(in.String())
+		case "stdoutDescription":
+			out.Output = string(in.String())
+		case "hints":
+			out.Hints = string(in.String())
+		case "tests":
+			(out.Tests).UnmarshalEasyJSON(in)
+		case "subtasks":
+			(out.Subtasks).UnmarshalEasyJSON(in)
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 string
+					v43 = string(in.String())
+					out.Tags = append(out.Tags, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "difficulty":
+			out.Difficulty = int(in.Int())
+		case "checker":
+			(out.Checker).UnmarshalEasyJSON(in)
+		case "limits":
+			(out.Limits).UnmarshalEasyJSON(in)
+		case "languages":
+			if in.IsNull() {
+				in.Skip()
+				out.Languages = nil
+			} else {
+				in.Delim('[')
+				if out.Languages == nil {
+					if !in.IsDelim(']') {
+						out.Languages = make([]string, 0, 4)
+					} else {
+						out.Languages = []string{}
+					}
+				} else {
+					out.Languages = (out.Languages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v44 string
+					v44 = string(in.String())
+					out.Languages = append(out.Languages, v44)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "creator":
+			out.Creator = uint64(in.Uint64())
+		case "is_private":
+			out.IsPrivate = bool(in.Bool())
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels26(out *jwriter.Writer, in TaskNew) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"stdinDescription\":"
+		out.RawString(prefix)
+		out.String(string(in.Input))
+	}
+	{
+		const prefix string = ",\"stdoutDescription\":"
+		out.RawString(prefix)
+		out.String(string(in.Output))
+	}
+	{
+		const prefix string = ",\"hints\":"
+		out.RawString(prefix)
+		out.String(string(in.Hints))
+	}
+	{
+		const prefix string = ",\"tests\":"
+		out.RawString(prefix)
+		(in.Tests).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"subtasks\":"
+		out.RawString(prefix)
+		(in.Subtasks).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v45, v46 := range in.Tags {
+				if v45 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v46))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"difficulty\":"
+		out.RawString(prefix)
+		out.Int(int(in.Difficulty))
+	}
+	{
+		const prefix string = ",\"checker\":"
+		out.RawString(prefix)
+		(in.Checker).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"limits\":"
+		out.RawString(prefix)
+		(in.Limits).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"languages\":"
+		out.RawString(prefix)
+		if in.Languages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Languages {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v48))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"creator\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Creator))
+	}
+	{
+		const prefix string = ",\"is_private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrivate))
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskNew) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskNew) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskNew) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels26(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels27(in *jlexer.Lexer, out *TaskLimits) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "time":
+			out.Time = int(in.Int())
+		case "memory":
+			out.Memory = int(in.Int())
+		case "output":
+			out.Output = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels27(out *jwriter.Writer, in TaskLimits) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Time))
+	}
+	{
+		const prefix string = ",\"memory\":"
+		out.RawString(prefix)
+		out.Int(int(in.Memory))
+	}
+	{
+		const prefix string = ",\"output\":"
+		out.RawString(prefix)
+		out.Int(int(in.Output))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskLimits) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskLimits) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskLimits) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskLimits) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels27(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels28(in *jlexer.Lexer, out *TaskGrants) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TaskGrants, 0, 1)
+			} else {
+				*out = TaskGrants{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v49 TaskGrant
+			(v49).UnmarshalEasyJSON(in)
+			*out = append(*out, v49)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels28(out *jwriter.Writer, in TaskGrants) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v50, v51 := range in {
+			if v50 > 0 {
+				out.RawByte(',')
+			}
+			(v51).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskGrants) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskGrants) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskGrants) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskGrants) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels28(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels29(in *jlexer.Lexer, out *TaskGrantNew) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "username":
+			out.Username = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels29(out *jwriter.Writer, in TaskGrantNew) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Username))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskGrantNew) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskGrantNew) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskGrantNew) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskGrantNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels29(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels30(in *jlexer.Lexer, out *TaskGrant) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "uid":
+			out.Uid = uint64(in.Uint64())
+		case "username":
+			out.Username = string(in.String())
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels30(out *jwriter.Writer, in TaskGrant) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"uid\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Uid))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskGrant) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskGrant) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskGrant) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskGrant) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels30(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels31(in *jlexer.Lexer, out *TaskGeneratorSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "TaskId":
+			out.TaskId = uint64(in.Uint64())
+		case "GeneratorLanguage":
+			out.GeneratorLanguage = string(in.String())
+		case "GeneratorCode":
+			out.GeneratorCode = string(in.String())
+		case "ReferenceLanguage":
+			out.ReferenceLanguage = string(in.String())
+		case "ReferenceCode":
+			out.ReferenceCode = string(in.String())
+		case "Script":
+			out.Script = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels31(out *jwriter.Writer, in TaskGeneratorSQL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"TaskId\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskId))
+	}
+	{
+		const prefix string = ",\"GeneratorLanguage\":"
+		out.RawString(prefix)
+		out.String(string(in.GeneratorLanguage))
+	}
+	{
+		const prefix string = ",\"GeneratorCode\":"
+		out.RawString(prefix)
+		out.String(string(in.GeneratorCode))
+	}
+	{
+		const prefix string = ",\"ReferenceLanguage\":"
+		out.RawString(prefix)
+		out.String(string(in.ReferenceLanguage))
+	}
+	{
+		const prefix string = ",\"ReferenceCode\":"
+		out.RawString(prefix)
+		out.String(string(in.ReferenceCode))
+	}
+	{
+		const prefix string = ",\"Script\":"
+		out.RawString(prefix)
+		out.String(string(in.Script))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskGeneratorSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskGeneratorSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskGeneratorSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskGeneratorSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels31(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels32(in *jlexer.Lexer, out *TaskGenerator) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "generator":
+			(out.Generator).UnmarshalEasyJSON(in)
+		case "reference":
+			(out.Reference).UnmarshalEasyJSON(in)
+		case "script":
+			(out.Script).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels32(out *jwriter.Writer, in TaskGenerator) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"generator\":"
+		out.RawString(prefix[1:])
+		(in.Generator).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"reference\":"
+		out.RawString(prefix)
+		(in.Reference).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"script\":"
+		out.RawString(prefix)
+		(in.Script).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskGenerator) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskGenerator) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskGenerator) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskGenerator) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels32(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels33(in *jlexer.Lexer, out *TaskFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Find":
+			out.Find = string(in.String())
+		case "Uid":
+			out.Uid = uint64(in.Uint64())
+		case "UseSolved":
+			out.UseSolved = bool(in.Bool())
+		case "Solved":
+			out.Solved = bool(in.Bool())
+		case "Mine":
+			out.Mine = bool(in.Bool())
+		case "Tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v52 string
+					v52 = string(in.String())
+					out.Tags = append(out.Tags, v52)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ExcludeTags":
+			if in.IsNull() {
+				in.Skip()
+				out.ExcludeTags = nil
+			} else {
+				in.Delim('[')
+				if out.ExcludeTags == nil {
+					if !in.IsDelim(']') {
+						out.ExcludeTags = make([]string, 0, 4)
+					} else {
+						out.ExcludeTags = []string{}
+					}
+				} else {
+					out.ExcludeTags = (out.ExcludeTags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v53 string
+					v53 = string(in.String())
+					out.ExcludeTags = append(out.ExcludeTags, v53)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "MinDifficulty":
+			out.MinDifficulty = int(in.Int())
+		case "MaxDifficulty":
+			out.MaxDifficulty = int(in.Int())
+		case "Page":
+			out.Page = int(in.Int())
+		case "Count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels33(out *jwriter.Writer, in TaskFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Find\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Find))
+	}
+	{
+		const prefix string = ",\"Uid\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Uid))
+	}
+	{
+		const prefix string = ",\"UseSolved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.UseSolved))
+	}
+	{
+		const prefix string = ",\"Solved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Solved))
+	}
+	{
+		const prefix string = ",\"Mine\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Mine))
+	}
+	{
+		const prefix string = ",\"Tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v54, v55 := range in.Tags {
+				if v54 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v55))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ExcludeTags\":"
+		out.RawString(prefix)
+		if in.ExcludeTags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v56, v57 := range in.ExcludeTags {
+				if v56 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v57))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"MinDifficulty\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinDifficulty))
+	}
+	{
+		const prefix string = ",\"MaxDifficulty\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxDifficulty))
+	}
+	{
+		const prefix string = ",\"Page\":"
+		out.RawString(prefix)
+		out.Int(int(in.Page))
+	}
+	{
+		const prefix string = ",\"Count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels33(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels34(in *jlexer.Lexer, out *TaskFacets) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tags":
+			(out.Tags).UnmarshalEasyJSON(in)
+		case "difficulties":
+			(out.Difficulties).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels34(out *jwriter.Writer, in TaskFacets) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix[1:])
+		(in.Tags).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"difficulties\":"
+		out.RawString(prefix)
+		(in.Difficulties).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskFacets) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskFacets) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskFacets) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskFacets) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels34(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels35(in *jlexer.Lexer, out *TaskChecker) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mode":
+			out.Mode = string(in.String())
+		case "absEpsilon":
+			out.AbsEpsilon = float64(in.Float64())
+		case "relEpsilon":
+			out.RelEpsilon = float64(in.Float64())
+		case "language":
+			out.Language = string(in.String())
+		case "sourceCode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.SourceCode = make(map[string]interface{})
+				} else {
+					out.SourceCode = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v58 interface{}
+					if m, ok := v58.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v58.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v58 = in.Interface()
+					}
+					(out.SourceCode)[key] = v58
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels35(out *jwriter.Writer, in TaskChecker) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mode\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Mode))
+	}
+	if in.AbsEpsilon != 0 {
+		const prefix string = ",\"absEpsilon\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AbsEpsilon))
+	}
+	if in.RelEpsilon != 0 {
+		const prefix string = ",\"relEpsilon\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RelEpsilon))
+	}
+	if in.Language != "" {
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	if len(in.SourceCode) != 0 {
+		const prefix string = ",\"sourceCode\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v59First := true
+			for v59Name, v59Value := range in.SourceCode {
+				if v59First {
+					v59First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v59Name))
+				out.RawByte(':')
+				if m, ok := v59Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v59Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v59Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskChecker) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskChecker) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskChecker) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskChecker) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels35(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels36(in *jlexer.Lexer, out *TaskAccessUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "isPrivate":
+			out.IsPrivate = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels36(out *jwriter.Writer, in TaskAccessUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"isPrivate\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsPrivate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskAccessUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskAccessUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskAccessUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskAccessUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels36(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels37(in *jlexer.Lexer, out *TaskAccess) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "isPrivate":
+			out.IsPrivate = bool(in.Bool())
+		case "code":
+			out.Code = string(in.String())
+		case "grants":
+			(out.Grants).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels37(out *jwriter.Writer, in TaskAccess) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"isPrivate\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsPrivate))
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"grants\":"
+		out.RawString(prefix)
+		(in.Grants).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TaskAccess) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TaskAccess) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TaskAccess) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TaskAccess) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels37(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels38(in *jlexer.Lexer, out *Task) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "name":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "stdinDescription":
+			out.Input = string(in.String())
+		case "stdoutDescription":
+			out.Output = string(in.String())
+		case "hints":
+			out.Hints = string(in.String())
+		case "testsAmount":
+			out.TestsAmount = int(in.Int())
+		case "tests":
+			(out.Tests).UnmarshalEasyJSON(in)
+		case "testsStored":
+			out.TestsStored = bool(in.Bool())
+		case "subtasks":
+			(out.Subtasks).UnmarshalEasyJSON(in)
+		case "maxScore":
+			out.MaxScore = int(in.Int())
+		case "score":
+			out.Score = int(in.Int())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v60 string
+					v60 = string(in.String())
+					out.Tags = append(out.Tags, v60)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "difficulty":
+			out.Difficulty = int(in.Int())
+		case "checker":
+			(out.Checker).UnmarshalEasyJSON(in)
+		case "limits":
+			(out.Limits).UnmarshalEasyJSON(in)
+		case "languages":
+			if in.IsNull() {
+				in.Skip()
+				out.Languages = nil
+			} else {
+				in.Delim('[')
+				if out.Languages == nil {
+					if !in.IsDelim(']') {
+						out.Languages = make([]string, 0, 4)
+					} else {
+						out.Languages = []string{}
+					}
+				} else {
+					out.Languages = (out.Languages)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v61 string
+					v61 = string(in.String())
+					out.Languages = append(out.Languages, v61)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "revision":
+			out.Revision = int(in.Int())
+		case "author":
+			out.Author = string(in.String())
+		case "authorId":
+			out.AuthorId = string(in.String())
+		case "isCleared":
+			out.IsCleared = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels38(out *jwriter.Writer, in Task) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"stdinDescription\":"
+		out.RawString(prefix)
+		out.String(string(in.Input))
+	}
+	{
+		const prefix string = ",\"stdoutDescription\":"
+		out.RawString(prefix)
+		out.String(string(in.Output))
+	}
+	{
+		const prefix string = ",\"hints\":"
+		out.RawString(prefix)
+		out.String(string(in.Hints))
+	}
+	{
+		const prefix string = ",\"testsAmount\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestsAmount))
+	}
+	{
+		const prefix string = ",\"tests\":"
+		out.RawString(prefix)
+		(in.Tests).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"testsStored\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.TestsStored))
+	}
+	{
+		const prefix string = ",\"subtasks\":"
+		out.RawString(prefix)
+		(in.Subtasks).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"maxScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxScore))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v62, v63 := range in.Tags {
+				if v62 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v63))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"difficulty\":"
+		out.RawString(prefix)
+		out.Int(int(in.Difficulty))
+	}
+	{
+		const prefix string = ",\"checker\":"
+		out.RawString(prefix)
+		(in.Checker).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"limits\":"
+		out.RawString(prefix)
+		(in.Limits).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"languages\":"
+		out.RawString(prefix)
+		if in.Languages == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v64, v65 := range in.Languages {
+				if v64 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v65))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"revision\":"
+		out.RawString(prefix)
+		out.Int(int(in.Revision))
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		out.String(string(in.Author))
+	}
+	{
+		const prefix string = ",\"authorId\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorId))
+	}
+	{
+		const prefix string = ",\"isCleared\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsCleared))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Task) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Task) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Task) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Task) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels38(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels39(in *jlexer.Lexer, out *TagCounts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TagCounts, 0, 2)
+			} else {
+				*out = TagCounts{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v66 TagCount
+			(v66).UnmarshalEasyJSON(in)
+			*out = append(*out, v66)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels39(out *jwriter.Writer, in TagCounts) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v67, v68 := range in {
+			if v67 > 0 {
+				out.RawByte(',')
+			}
+			(v68).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TagCounts) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TagCounts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TagCounts) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TagCounts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels39(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels40(in *jlexer.Lexer, out *TagCount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "tag":
+			out.Tag = string(in.String())
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels40(out *jwriter.Writer, in TagCount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"tag\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Tag))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TagCount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TagCount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TagCount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TagCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels40(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels41(in *jlexer.Lexer, out *SubtaskResults) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(SubtaskResults, 0, 1)
+			} else {
+				*out = SubtaskResults{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v69 SubtaskResult
+			(v69).UnmarshalEasyJSON(in)
+			*out = append(*out, v69)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels41(out *jwriter.Writer, in SubtaskResults) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v70, v71 := range in {
+			if v70 > 0 {
+				out.RawByte(',')
+			}
+			(v71).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SubtaskResults) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SubtaskResults) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SubtaskResults) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SubtaskResults) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels41(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels42(in *jlexer.Lexer, out *SubtaskResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "num":
+			out.Num = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "points":
+			out.Points = int(in.Int())
+		case "score":
+			out.Score = int(in.Int())
+		case "passed":
+			out.Passed = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels42(out *jwriter.Writer, in SubtaskResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"num\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Num))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"points\":"
+		out.RawString(prefix)
+		out.Int(int(in.Points))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	{
+		const prefix string = ",\"passed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Passed))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SubtaskResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SubtaskResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SubtaskResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SubtaskResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels42(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels43(in *jlexer.Lexer, out *SolutionsSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(SolutionsSQL, 0, 0)
+			} else {
+				*out = SolutionsSQL{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v72 SolutionSQL
+			(v72).UnmarshalEasyJSON(in)
+			*out = append(*out, v72)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels43(out *jwriter.Writer, in SolutionsSQL) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v73, v74 := range in {
+			if v73 > 0 {
+				out.RawByte(',')
+			}
+			(v74).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionsSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionsSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionsSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionsSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels43(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels44(in *jlexer.Lexer, out *Solutions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(Solutions, 0, 0)
+			} else {
+				*out = Solutions{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v75 SolutionOne
+			(v75).UnmarshalEasyJSON(in)
+			*out = append(*out, v75)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels44(out *jwriter.Writer, in Solutions) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v76, v77 := range in {
+			if v76 > 0 {
+				out.RawByte(',')
+			}
+			(v77).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Solutions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Solutions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Solutions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Solutions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels44(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels45(in *jlexer.Lexer, out *SolutionUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "currentTest":
+			out.CurrentTest = int(in.Int())
+		case "checkResult":
+			out.Code = Verdict(in.Int())
+		case "checkMessage":
+			out.CheckMessage = string(in.String())
+		case "checkedDatetime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CheckedDateTime).UnmarshalJSON(data))
+			}
+		case "checkTime":
+			out.CheckTime = float32(in.Float32())
+		case "buildTime":
+			out.CompileTime = float32(in.Float32())
+		case "testsPassed":
+			out.Passed = int(in.Int())
+		case "testsTotal":
+			out.TestsTotal = int(in.Int())
+		case "tests":
+			(out.Tests).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels45(out *jwriter.Writer, in SolutionUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"currentTest\":"
+		out.RawString(prefix)
+		out.Int(int(in.CurrentTest))
+	}
+	{
+		const prefix string = ",\"checkResult\":"
+		out.RawString(prefix)
+		out.Int(int(in.Code))
+	}
+	{
+		const prefix string = ",\"checkMessage\":"
+		out.RawString(prefix)
+		out.String(string(in.CheckMessage))
+	}
+	{
+		const prefix string = ",\"checkedDatetime\":"
+		out.RawString(prefix)
+		out.Raw((in.CheckedDateTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"checkTime\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.CheckTime))
+	}
+	{
+		const prefix string = ",\"buildTime\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.CompileTime))
+	}
+	{
+		const prefix string = ",\"testsPassed\":"
+		out.RawString(prefix)
+		out.Int(int(in.Passed))
+	}
+	{
+		const prefix string = ",\"testsTotal\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestsTotal))
+	}
+	{
+		const prefix string = ",\"tests\":"
+		out.RawString(prefix)
+		(in.Tests).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels45(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels46(in *jlexer.Lexer, out *SolutionTestsSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(SolutionTestsSQL, 0, 0)
+			} else {
+				*out = SolutionTestsSQL{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v78 SolutionTestSQL
+			(v78).UnmarshalEasyJSON(in)
+			*out = append(*out, v78)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels46(out *jwriter.Writer, in SolutionTestsSQL) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v79, v80 := range in {
+			if v79 > 0 {
+				out.RawByte(',')
+			}
+			(v80).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionTestsSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionTestsSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionTestsSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionTestsSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels46(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels47(in *jlexer.Lexer, out *SolutionTestSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			out.Id = uint64(in.Uint64())
+		case "SolutionId":
+			out.SolutionId = uint64(in.Uint64())
+		case "TestNum":
+			out.TestNum = int(in.Int())
+		case "Passed":
+			out.Passed = bool(in.Bool())
+		case "Verdict":
+			out.Verdict = Verdict(in.Int())
+		case "Stdout":
+			out.Stdout = string(in.String())
+		case "Stderr":
+			out.Stderr = string(in.String())
+		case "RunTime":
+			out.RunTime = float32(in.Float32())
+		case "Memory":
+			out.Memory = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels47(out *jwriter.Writer, in SolutionTestSQL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"SolutionId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.SolutionId))
+	}
+	{
+		const prefix string = ",\"TestNum\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestNum))
+	}
+	{
+		const prefix string = ",\"Passed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Passed))
+	}
+	{
+		const prefix string = ",\"Verdict\":"
+		out.RawString(prefix)
+		out.Int(int(in.Verdict))
+	}
+	{
+		const prefix string = ",\"Stdout\":"
+		out.RawString(prefix)
+		out.String(string(in.Stdout))
+	}
+	{
+		const prefix string = ",\"Stderr\":"
+		out.RawString(prefix)
+		out.String(string(in.Stderr))
+	}
+	{
+		const prefix string = ",\"RunTime\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.RunTime))
+	}
+	{
+		const prefix string = ",\"Memory\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Memory))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionTestSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionTestSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionTestSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionTestSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels47(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels48(in *jlexer.Lexer, out *SolutionSend) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "nonce":
+			out.Nonce = string(in.String())
+		case "sourceCode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.SourceCode = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v81 interface{}
+					if m, ok := v81.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v81.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v81 = in.Interface()
+					}
+					(out.SourceCode)[key] = v81
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "tests":
+			(out.Tests).UnmarshalEasyJSON(in)
+		case "checker":
+			(out.Checker).UnmarshalEasyJSON(in)
+		case "limits":
+			(out.Limits).UnmarshalEasyJSON(in)
+		case "language":
+			(out.Language).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels48(out *jwriter.Writer, in SolutionSend) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"nonce\":"
+		out.RawString(prefix)
+		out.String(string(in.Nonce))
+	}
+	{
+		const prefix string = ",\"sourceCode\":"
+		out.RawString(prefix)
+		if in.SourceCode == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v82First := true
+			for v82Name, v82Value := range in.SourceCode {
+				if v82First {
+					v82First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v82Name))
+				out.RawByte(':')
+				if m, ok := v82Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v82Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v82Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"tests\":"
+		out.RawString(prefix)
+		(in.Tests).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"checker\":"
+		out.RawString(prefix)
+		(in.Checker).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"limits\":"
+		out.RawString(prefix)
+		(in.Limits).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		(in.Language).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionSend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionSend) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionSend) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionSend) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels48(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels49(in *jlexer.Lexer, out *SolutionSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			out.Id = uint64(in.Uint64())
+		case "ReceivedDateTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ReceivedDateTime).UnmarshalJSON(data))
+			}
+		case "CheckedDateTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CheckedDateTime).UnmarshalJSON(data))
+			}
+		case "SourceCode":
+			out.SourceCode = string(in.String())
+		case "TaskId":
+			out.TaskId = uint64(in.Uint64())
+		case "CheckResult":
+			out.CheckResult = Verdict(in.Int())
+		case "CheckTime":
+			out.CheckTime = float32(in.Float32())
+		case "CompileTime":
+			out.CompileTime = float32(in.Float32())
+		case "CheckMessage":
+			out.CheckMessage = string(in.String())
+		case "TestsPassed":
+			out.TestsPassed = int(in.Int())
+		case "TestsTotal":
+			out.TestsTotal = int(in.Int())
+		case "Score":
+			out.Score = int(in.Int())
+		case "TaskRevision":
+			out.TaskRevision = int(in.Int())
+		case "Uid":
+			out.Uid = uint64(in.Uint64())
+		case "Language":
+			out.Language = string(in.String())
+		case "Username":
+			out.Username = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels49(out *jwriter.Writer, in SolutionSQL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"ReceivedDateTime\":"
+		out.RawString(prefix)
+		out.Raw((in.ReceivedDateTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"CheckedDateTime\":"
+		out.RawString(prefix)
+		out.Raw((in.CheckedDateTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"SourceCode\":"
+		out.RawString(prefix)
+		out.String(string(in.SourceCode))
+	}
+	{
+		const prefix string = ",\"TaskId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskId))
+	}
+	{
+		const prefix string = ",\"CheckResult\":"
+		out.RawString(prefix)
+		out.Int(int(in.CheckResult))
+	}
+	{
+		const prefix string = ",\"CheckTime\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.CheckTime))
+	}
+	{
+		const prefix string = ",\"CompileTime\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.CompileTime))
+	}
+	{
+		const prefix string = ",\"CheckMessage\":"
+		out.RawString(prefix)
+		out.String(string(in.CheckMessage))
+	}
+	{
+		const prefix string = ",\"TestsPassed\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestsPassed))
+	}
+	{
+		const prefix string = ",\"TestsTotal\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestsTotal))
+	}
+	{
+		const prefix string = ",\"Score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	{
+		const prefix string = ",\"TaskRevision\":"
+		out.RawString(prefix)
+		out.Int(int(in.TaskRevision))
+	}
+	{
+		const prefix string = ",\"Uid\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Uid))
+	}
+	{
+		const prefix string = ",\"Language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"Username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels49(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels50(in *jlexer.Lexer, out *SolutionPosted) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "checkResult":
+			out.CheckResult = Verdict(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels50(out *jwriter.Writer, in SolutionPosted) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"checkResult\":"
+		out.RawString(prefix)
+		out.Int(int(in.CheckResult))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionPosted) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionPosted) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionPosted) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionPosted) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels50(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels51(in *jlexer.Lexer, out *SolutionOne) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "taskId":
+			out.TaskId = uint64(in.Uint64())
+		case "uid":
+			out.Uid = uint64(in.Uint64())
+		case "username":
+			out.Username = string(in.String())
+		case "language":
+			out.Language = string(in.String())
+		case "sourceCode":
+			out.SourceCode = string(in.String())
+		case "receivedDatetime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ReceivedDateTime).UnmarshalJSON(data))
+			}
+		case "checkedDatetime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CheckedDateTime).UnmarshalJSON(data))
+			}
+		case "checkResult":
+			out.CheckResult = Verdict(in.Int())
+		case "verdict":
+			out.Verdict = string(in.String())
+		case "checkTime":
+			out.CheckTime = float32(in.Float32())
+		case "checkMessage":
+			out.CheckMessage = string(in.String())
+		case "compileTime":
+			out.CompileTime = float32(in.Float32())
+		case "testsPassed":
+			out.TestsPassed = int(in.Int())
+		case "testsTotal":
+			out.TestsTotal = int(in.Int())
+		case "score":
+			out.Score = int(in.Int())
+		case "taskRevision":
+			out.TaskRevision = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels51(out *jwriter.Writer, in SolutionOne) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"taskId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskId))
+	}
+	{
+		const prefix string = ",\"uid\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Uid))
+	}
+	if in.Username != "" {
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"sourceCode\":"
+		out.RawString(prefix)
+		out.String(string(in.SourceCode))
+	}
+	{
+		const prefix string = ",\"receivedDatetime\":"
+		out.RawString(prefix)
+		out.Raw((in.ReceivedDateTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"checkedDatetime\":"
+		out.RawString(prefix)
+		out.Raw((in.CheckedDateTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"checkResult\":"
+		out.RawString(prefix)
+		out.Int(int(in.CheckResult))
+	}
+	{
+		const prefix string = ",\"verdict\":"
+		out.RawString(prefix)
+		out.String(string(in.Verdict))
+	}
+	{
+		const prefix string = ",\"checkTime\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.CheckTime))
+	}
+	{
+		const prefix string = ",\"checkMessage\":"
+		out.RawString(prefix)
+		out.String(string(in.CheckMessage))
+	}
+	{
+		const prefix string = ",\"compileTime\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.CompileTime))
+	}
+	{
+		const prefix string = ",\"testsPassed\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestsPassed))
+	}
+	{
+		const prefix string = ",\"testsTotal\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestsTotal))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	{
+		const prefix string = ",\"taskRevision\":"
+		out.RawString(prefix)
+		out.Int(int(in.TaskRevision))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionOne) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionOne) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionOne) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionOne) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels51(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels52(in *jlexer.Lexer, out *SolutionFull) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "language":
+			out.Language = string(in.String())
+		case "sourceCode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.SourceCode = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v83 interface{}
+					if m, ok := v83.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v83.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v83 = in.Interface()
+					}
+					(out.SourceCode)[key] = v83
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "receivedDatetime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ReceivedDateTime).UnmarshalJSON(data))
+			}
+		case "checkedDatetime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CheckedDateTime).UnmarshalJSON(data))
+			}
+		case "checkResult":
+			out.CheckResult = Verdict(in.Int())
+		case "verdict":
+			out.Verdict = string(in.String())
+		case "checkMessage":
+			out.CheckMessage = string(in.String())
+		case "checkTime":
+			out.CheckTime = float32(in.Float32())
+		case "compileTime":
+			out.CompileTime = float32(in.Float32())
+		case "tests":
+			(out.Tests).UnmarshalEasyJSON(in)
+		case "testsPassed":
+			out.TestsPassed = int(in.Int())
+		case "testsTotal":
+			out.TestsTotal = int(in.Int())
+		case "score":
+			out.Score = int(in.Int())
+		case "maxScore":
+			out.MaxScore = int(in.Int())
+		case "taskRevision":
+			out.TaskRevision = int(in.Int())
+		case "subtasks":
+			(out.Subtasks).UnmarshalEasyJSON(in)
+		case "reviews":
+			(out.Reviews).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels52(out *jwriter.Writer, in SolutionFull) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"sourceCode\":"
+		out.RawString(prefix)
+		if in.SourceCode == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v84First := true
+			for v84Name, v84Value := range in.SourceCode {
+				if v84First {
+					v84First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v84Name))
+				out.RawByte(':')
+				if m, ok := v84Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v84Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v84Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"receivedDatetime\":"
+		out.RawString(prefix)
+		out.Raw((in.ReceivedDateTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"checkedDatetime\":"
+		out.RawString(prefix)
+		out.Raw((in.CheckedDateTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"checkResult\":"
+		out.RawString(prefix)
+		out.Int(int(in.CheckResult))
+	}
+	{
+		const prefix string = ",\"verdict\":"
+		out.RawString(prefix)
+		out.String(string(in.Verdict))
+	}
+	{
+		const prefix string = ",\"checkMessage\":"
+		out.RawString(prefix)
+		out.String(string(in.CheckMessage))
+	}
+	{
+		const prefix string = ",\"checkTime\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.CheckTime))
+	}
+	{
+		const prefix string = ",\"compileTime\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.CompileTime))
+	}
+	{
+		const prefix string = ",\"tests\":"
+		out.RawString(prefix)
+		(in.Tests).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"testsPassed\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestsPassed))
+	}
+	{
+		const prefix string = ",\"testsTotal\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestsTotal))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	{
+		const prefix string = ",\"maxScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxScore))
+	}
+	{
+		const prefix string = ",\"taskRevision\":"
+		out.RawString(prefix)
+		out.Int(int(in.TaskRevision))
+	}
+	{
+		const prefix string = ",\"subtasks\":"
+		out.RawString(prefix)
+		(in.Subtasks).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"reviews\":"
+		out.RawString(prefix)
+		(in.Reviews).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionFull) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionFull) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionFull) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionFull) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels52(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels53(in *jlexer.Lexer, out *SolutionFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "TaskId":
+			out.TaskId = uint64(in.Uint64())
+		case "Uid":
+			out.Uid = uint64(in.Uint64())
+		case "AllUsers":
+			out.AllUsers = bool(in.Bool())
+		case "UseVerdict":
+			out.UseVerdict = bool(in.Bool())
+		case "Verdict":
+			out.Verdict = Verdict(in.Int())
+		case "Language":
+			out.Language = string(in.String())
+		case "From":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.From).UnmarshalJSON(data))
+			}
+		case "To":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.To).UnmarshalJSON(data))
+			}
+		case "Cursor":
+			out.Cursor = uint64(in.Uint64())
+		case "Ascending":
+			out.Ascending = bool(in.Bool())
+		case "Count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels53(out *jwriter.Writer, in SolutionFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"TaskId\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskId))
+	}
+	{
+		const prefix string = ",\"Uid\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Uid))
+	}
+	{
+		const prefix string = ",\"AllUsers\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AllUsers))
+	}
+	{
+		const prefix string = ",\"UseVerdict\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.UseVerdict))
+	}
+	{
+		const prefix string = ",\"Verdict\":"
+		out.RawString(prefix)
+		out.Int(int(in.Verdict))
+	}
+	{
+		const prefix string = ",\"Language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"From\":"
+		out.RawString(prefix)
+		out.Raw((in.From).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"To\":"
+		out.RawString(prefix)
+		out.Raw((in.To).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Cursor\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Cursor))
+	}
+	{
+		const prefix string = ",\"Ascending\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Ascending))
+	}
+	{
+		const prefix string = ",\"Count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels53(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels54(in *jlexer.Lexer, out *SolutionFile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sourceCode":
+			out.SourceCode = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels54(out *jwriter.Writer, in SolutionFile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sourceCode\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.SourceCode))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionFile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionFile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionFile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionFile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels54(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels55(in *jlexer.Lexer, out *SolutionEvent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "taskId":
+			out.TaskId = uint64(in.Uint64())
+		case "status":
+			out.Status = string(in.String())
+		case "currentTest":
+			out.CurrentTest = int(in.Int())
+		case "checkResult":
+			out.CheckResult = Verdict(in.Int())
+		case "checkMessage":
+			out.CheckMessage = string(in.String())
+		case "testsPassed":
+			out.TestsPassed = int(in.Int())
+		case "testsTotal":
+			out.TestsTotal = int(in.Int())
+		case "score":
+			out.Score = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels55(out *jwriter.Writer, in SolutionEvent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"taskId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskId))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	if in.CurrentTest != 0 {
+		const prefix string = ",\"currentTest\":"
+		out.RawString(prefix)
+		out.Int(int(in.CurrentTest))
+	}
+	{
+		const prefix string = ",\"checkResult\":"
+		out.RawString(prefix)
+		out.Int(int(in.CheckResult))
+	}
+	{
+		const prefix string = ",\"checkMessage\":"
+		out.RawString(prefix)
+		out.String(string(in.CheckMessage))
+	}
+	{
+		const prefix string = ",\"testsPassed\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestsPassed))
+	}
+	{
+		const prefix string = ",\"testsTotal\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestsTotal))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionEvent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionEvent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionEvent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionEvent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels55(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels56(in *jlexer.Lexer, out *SolutionDiff) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "from":
+			out.From = uint64(in.Uint64())
+		case "to":
+			out.To = uint64(in.Uint64())
+		case "files":
+			(out.Files).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels56(out *jwriter.Writer, in SolutionDiff) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.From))
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.To))
+	}
+	{
+		const prefix string = ",\"files\":"
+		out.RawString(prefix)
+		(in.Files).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SolutionDiff) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SolutionDiff) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SolutionDiff) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SolutionDiff) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels56(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels57(in *jlexer.Lexer, out *Solution) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "language":
+			out.Language = string(in.String())
+		case "sourceCode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.SourceCode = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v85 interface{}
+					if m, ok := v85.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v85.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v85 = in.Interface()
+					}
+					(out.SourceCode)[key] = v85
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels57(out *jwriter.Writer, in Solution) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"sourceCode\":"
+		out.RawString(prefix)
+		if in.SourceCode == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v86First := true
+			for v86Name, v86Value := range in.SourceCode {
+				if v86First {
+					v86First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v86Name))
+				out.RawByte(':')
+				if m, ok := v86Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v86Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v86Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Solution) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Solution) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Solution) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Solution) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels57(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels58(in *jlexer.Lexer, out *ShortTasks) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ShortTasks, 0, 0)
+			} else {
+				*out = ShortTasks{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v87 ShortTask
+			(v87).UnmarshalEasyJSON(in)
+			*out = append(*out, v87)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels58(out *jwriter.Writer, in ShortTasks) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v88, v89 := range in {
+			if v88 > 0 {
+				out.RawByte(',')
+			}
+			(v89).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ShortTasks) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ShortTasks) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ShortTasks) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ShortTasks) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels58(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels59(in *jlexer.Lexer, out *ShortTask) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "name":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "testsAmount":
+			out.TestAmount = int(in.Int())
+		case "maxScore":
+			out.MaxScore = int(in.Int())
+		case "tags":
+			if in.IsNull() {
+				in.Skip()
+				out.Tags = nil
+			} else {
+				in.Delim('[')
+				if out.Tags == nil {
+					if !in.IsDelim(']') {
+						out.Tags = make([]string, 0, 4)
+					} else {
+						out.Tags = []string{}
+					}
+				} else {
+					out.Tags = (out.Tags)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v90 string
+					v90 = string(in.String())
+					out.Tags = append(out.Tags, v90)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "difficulty":
+			out.Difficulty = int(in.Int())
+		case "score":
+			out.Score = int(in.Int())
+		case "isCleared":
+			out.IsCleared = bool(in.Bool())
+		case "headline":
+			out.Headline = string(in.String())
+		case "creator":
+			out.Creator = string(in.String())
+		case "creatorId":
+			out.CreatorId = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels59(out *jwriter.Writer, in ShortTask) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"testsAmount\":"
+		out.RawString(prefix)
+		out.Int(int(in.TestAmount))
+	}
+	{
+		const prefix string = ",\"maxScore\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxScore))
+	}
+	{
+		const prefix string = ",\"tags\":"
+		out.RawString(prefix)
+		if in.Tags == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v91, v92 := range in.Tags {
+				if v91 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v92))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"difficulty\":"
+		out.RawString(prefix)
+		out.Int(int(in.Difficulty))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Int(int(in.Score))
+	}
+	{
+		const prefix string = ",\"isCleared\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsCleared))
+	}
+	if in.Headline != "" {
+		const prefix string = ",\"headline\":"
+		out.RawString(prefix)
+		out.String(string(in.Headline))
+	}
+	{
+		const prefix string = ",\"creator\":"
+		out.RawString(prefix)
+		out.String(string(in.Creator))
+	}
+	{
+		const prefix string = ",\"creatorId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.CreatorId))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ShortTask) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ShortTask) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ShortTask) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ShortTask) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels59(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels60(in *jlexer.Lexer, out *RunSend) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sourceCode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.SourceCode = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v93 interface{}
+					if m, ok := v93.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v93.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v93 = in.Interface()
+					}
+					(out.SourceCode)[key] = v93
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "stdin":
+			out.Stdin = string(in.String())
+		case "limits":
+			(out.Limits).UnmarshalEasyJSON(in)
+		case "language":
+			(out.Language).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels60(out *jwriter.Writer, in RunSend) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sourceCode\":"
+		out.RawString(prefix[1:])
+		if in.SourceCode == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v94First := true
+			for v94Name, v94Value := range in.SourceCode {
+				if v94First {
+					v94First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v94Name))
+				out.RawByte(':')
+				if m, ok := v94Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v94Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v94Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"stdin\":"
+		out.RawString(prefix)
+		out.String(string(in.Stdin))
+	}
+	{
+		const prefix string = ",\"limits\":"
+		out.RawString(prefix)
+		(in.Limits).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		(in.Language).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RunSend) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RunSend) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RunSend) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RunSend) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels60(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels61(in *jlexer.Lexer, out *RunResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "checkResult":
+			out.Verdict = Verdict(in.Int())
+		case "verdict":
+			out.VerdictName = string(in.String())
+		case "checkMessage":
+			out.CheckMessage = string(in.String())
+		case "stdout":
+			out.Stdout = string(in.String())
+		case "stderr":
+			out.Stderr = string(in.String())
+		case "time":
+			out.RunTime = float32(in.Float32())
+		case "buildTime":
+			out.CompileTime = float32(in.Float32())
+		case "memory":
+			out.Memory = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels61(out *jwriter.Writer, in RunResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"checkResult\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Verdict))
+	}
+	{
+		const prefix string = ",\"verdict\":"
+		out.RawString(prefix)
+		out.String(string(in.VerdictName))
+	}
+	{
+		const prefix string = ",\"checkMessage\":"
+		out.RawString(prefix)
+		out.String(string(in.CheckMessage))
+	}
+	{
+		const prefix string = ",\"stdout\":"
+		out.RawString(prefix)
+		out.String(string(in.Stdout))
+	}
+	{
+		const prefix string = ",\"stderr\":"
+		out.RawString(prefix)
+		out.String(string(in.Stderr))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.RunTime))
+	}
+	{
+		const prefix string = ",\"buildTime\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.CompileTime))
+	}
+	{
+		const prefix string = ",\"memory\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Memory))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RunResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels61(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RunResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels61(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RunResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels61(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RunResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels61(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels62(in *jlexer.Lexer, out *RunRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "language":
+			out.Language = string(in.String())
+		case "sourceCode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.SourceCode = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v95 interface{}
+					if m, ok := v95.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v95.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v95 = in.Interface()
+					}
+					(out.SourceCode)[key] = v95
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "stdin":
+			out.Stdin = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels62(out *jwriter.Writer, in RunRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"sourceCode\":"
+		out.RawString(prefix)
+		if in.SourceCode == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v96First := true
+			for v96Name, v96Value := range in.SourceCode {
+				if v96First {
+					v96First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v96Name))
+				out.RawByte(':')
+				if m, ok := v96Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v96Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v96Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"stdin\":"
+		out.RawString(prefix)
+		out.String(string(in.Stdin))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RunRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RunRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RunRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RunRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels62(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels63(in *jlexer.Lexer, out *ReviewThreadsSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ReviewThreadsSQL, 0, 0)
+			} else {
+				*out = ReviewThreadsSQL{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v97 ReviewThreadSQL
+			(v97).UnmarshalEasyJSON(in)
+			*out = append(*out, v97)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels63(out *jwriter.Writer, in ReviewThreadsSQL) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v98, v99 := range in {
+			if v98 > 0 {
+				out.RawByte(',')
+			}
+			(v99).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewThreadsSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels63(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewThreadsSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels63(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewThreadsSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels63(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewThreadsSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels63(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels64(in *jlexer.Lexer, out *ReviewThreads) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ReviewThreads, 0, 0)
+			} else {
+				*out = ReviewThreads{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v100 ReviewThread
+			(v100).UnmarshalEasyJSON(in)
+			*out = append(*out, v100)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels64(out *jwriter.Writer, in ReviewThreads) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v101, v102 := range in {
+			if v101 > 0 {
+				out.RawByte(',')
+			}
+			(v102).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewThreads) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels64(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewThreads) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels64(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewThreads) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels64(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewThreads) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels64(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels65(in *jlexer.Lexer, out *ReviewThreadSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			out.Id = uint64(in.Uint64())
+		case "SolutionId":
+			out.SolutionId = uint64(in.Uint64())
+		case "FileName":
+			out.FileName = string(in.String())
+		case "LineStart":
+			out.LineStart = int(in.Int())
+		case "LineEnd":
+			out.LineEnd = int(in.Int())
+		case "Uid":
+			out.Uid = uint64(in.Uint64())
+		case "Resolved":
+			out.Resolved = bool(in.Bool())
+		case "CreatedDateTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedDateTime).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels65(out *jwriter.Writer, in ReviewThreadSQL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"SolutionId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.SolutionId))
+	}
+	{
+		const prefix string = ",\"FileName\":"
+		out.RawString(prefix)
+		out.String(string(in.FileName))
+	}
+	{
+		const prefix string = ",\"LineStart\":"
+		out.RawString(prefix)
+		out.Int(int(in.LineStart))
+	}
+	{
+		const prefix string = ",\"LineEnd\":"
+		out.RawString(prefix)
+		out.Int(int(in.LineEnd))
+	}
+	{
+		const prefix string = ",\"Uid\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Uid))
+	}
+	{
+		const prefix string = ",\"Resolved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Resolved))
+	}
+	{
+		const prefix string = ",\"CreatedDateTime\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedDateTime).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewThreadSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels65(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewThreadSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels65(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewThreadSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels65(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewThreadSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels65(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels66(in *jlexer.Lexer, out *ReviewThreadNew) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "fileName":
+			out.FileName = string(in.String())
+		case "lineStart":
+			out.LineStart = int(in.Int())
+		case "lineEnd":
+			out.LineEnd = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels66(out *jwriter.Writer, in ReviewThreadNew) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"fileName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.FileName))
+	}
+	{
+		const prefix string = ",\"lineStart\":"
+		out.RawString(prefix)
+		out.Int(int(in.LineStart))
+	}
+	{
+		const prefix string = ",\"lineEnd\":"
+		out.RawString(prefix)
+		out.Int(int(in.LineEnd))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewThreadNew) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels66(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewThreadNew) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels66(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewThreadNew) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels66(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewThreadNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels66(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels67(in *jlexer.Lexer, out *ReviewThread) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "solutionId":
+			out.SolutionId = uint64(in.Uint64())
+		case "fileName":
+			out.FileName = string(in.String())
+		case "lineStart":
+			out.LineStart = int(in.Int())
+		case "lineEnd":
+			out.LineEnd = int(in.Int())
+		case "resolved":
+			out.Resolved = bool(in.Bool())
+		case "createdDatetime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedDateTime).UnmarshalJSON(data))
+			}
+		case "comments":
+			(out.Comments).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels67(out *jwriter.Writer, in ReviewThread) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"solutionId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.SolutionId))
+	}
+	{
+		const prefix string = ",\"fileName\":"
+		out.RawString(prefix)
+		out.String(string(in.FileName))
+	}
+	{
+		const prefix string = ",\"lineStart\":"
+		out.RawString(prefix)
+		out.Int(int(in.LineStart))
+	}
+	{
+		const prefix string = ",\"lineEnd\":"
+		out.RawString(prefix)
+		out.Int(int(in.LineEnd))
+	}
+	{
+		const prefix string = ",\"resolved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Resolved))
+	}
+	{
+		const prefix string = ",\"createdDatetime\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedDateTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		(in.Comments).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewThread) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels67(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewThread) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels67(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewThread) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels67(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewThread) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels67(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels68(in *jlexer.Lexer, out *ReviewResolve) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "resolved":
+			out.Resolved = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels68(out *jwriter.Writer, in ReviewResolve) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"resolved\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Resolved))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewResolve) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels68(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewResolve) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels68(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewResolve) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels68(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewResolve) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels68(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels69(in *jlexer.Lexer, out *ReviewReply) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels69(out *jwriter.Writer, in ReviewReply) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewReply) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels69(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewReply) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels69(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewReply) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels69(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewReply) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels69(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels70(in *jlexer.Lexer, out *ReviewCommentsSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ReviewCommentsSQL, 0, 0)
+			} else {
+				*out = ReviewCommentsSQL{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v103 ReviewCommentSQL
+			(v103).UnmarshalEasyJSON(in)
+			*out = append(*out, v103)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels70(out *jwriter.Writer, in ReviewCommentsSQL) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v104, v105 := range in {
+			if v104 > 0 {
+				out.RawByte(',')
+			}
+			(v105).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewCommentsSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels70(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewCommentsSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels70(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewCommentsSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels70(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewCommentsSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels70(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels71(in *jlexer.Lexer, out *ReviewComments) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ReviewComments, 0, 0)
+			} else {
+				*out = ReviewComments{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v106 ReviewComment
+			(v106).UnmarshalEasyJSON(in)
+			*out = append(*out, v106)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels71(out *jwriter.Writer, in ReviewComments) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v107, v108 := range in {
+			if v107 > 0 {
+				out.RawByte(',')
+			}
+			(v108).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewComments) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels71(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewComments) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels71(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewComments) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels71(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewComments) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels71(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels72(in *jlexer.Lexer, out *ReviewCommentSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "Id":
+			out.Id = uint64(in.Uint64())
+		case "ThreadId":
+			out.ThreadId = uint64(in.Uint64())
+		case "Uid":
+			out.Uid = uint64(in.Uint64())
+		case "Text":
+			out.Text = string(in.String())
+		case "CreatedDateTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedDateTime).UnmarshalJSON(data))
+			}
+		case "Username":
+			out.Username = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels72(out *jwriter.Writer, in ReviewCommentSQL) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"ThreadId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ThreadId))
+	}
+	{
+		const prefix string = ",\"Uid\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Uid))
+	}
+	{
+		const prefix string = ",\"Text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"CreatedDateTime\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedDateTime).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewCommentSQL) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels72(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewCommentSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels72(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewCommentSQL) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels72(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewCommentSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels72(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels73(in *jlexer.Lexer, out *ReviewComment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "uid":
+			out.Uid = uint64(in.Uint64())
+		case "username":
+			out.Username = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "createdDatetime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedDateTime).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels73(out *jwriter.Writer, in ReviewComment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"uid\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Uid))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"createdDatetime\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedDateTime).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewComment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels73(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewComment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels73(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewComment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels73(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels73(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels74(in *jlexer.Lexer, out *ReturnId) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels74(out *jwriter.Writer, in ReturnId) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReturnId) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels74(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReturnId) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels74(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReturnId) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels74(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReturnId) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels74(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels75(in *jlexer.Lexer, out *RejudgeStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "taskId":
+			out.TaskId = uint64(in.Uint64())
+		case "total":
+			out.Total = int(in.Int())
+		case "done":
+			out.Done = int(in.Int())
+		case "pending":
+			out.Pending = int(in.Int())
+		case "startedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StartedAt).UnmarshalJSON(data))
+			}
+		case "inProgress":
+			out.InProgress = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels75(out *jwriter.Writer, in RejudgeStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"taskId\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TaskId))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"done\":"
+		out.RawString(prefix)
+		out.Int(int(in.Done))
+	}
+	{
+		const prefix string = ",\"pending\":"
+		out.RawString(prefix)
+		out.Int(int(in.Pending))
+	}
+	{
+		const prefix string = ",\"startedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.StartedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"inProgress\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.InProgress))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RejudgeStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels75(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RejudgeStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels75(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RejudgeStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels75(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RejudgeStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels75(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels76(in *jlexer.Lexer, out *Program) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -932,24 +7813,318 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels10(in *jlexer.Lexer, out *T
 			continue
 		}
 		switch key {
-		case "name":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "stdinDescription":
-			out.Input = string(in.String())
-		case "stdoutDescription":
-			out.Output = string(in.String())
-		case "hints":
-			out.Hints = string(in.String())
-		case "tests":
-			(out.Tests).UnmarshalEasyJSON(in)
-		case "creator":
-			out.Creator = uint64(in.Uint64())
-		case "is_private":
-			out.IsPrivate = bool(in.Bool())
-		case "code":
-			out.Code = string(in.String())
+		case "language":
+			out.Language = string(in.String())
+		case "sourceCode":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.SourceCode = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v109 interface{}
+					if m, ok := v109.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v109.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v109 = in.Interface()
+					}
+					(out.SourceCode)[key] = v109
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels76(out *jwriter.Writer, in Program) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"sourceCode\":"
+		out.RawString(prefix)
+		if in.SourceCode == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v110First := true
+			for v110Name, v110Value := range in.SourceCode {
+				if v110First {
+					v110First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v110Name))
+				out.RawByte(':')
+				if m, ok := v110Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v110Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v110Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Program) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels76(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Program) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels76(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Program) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels76(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Program) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels76(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels77(in *jlexer.Lexer, out *PlagiarismView) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pair":
+			(out.Pair).UnmarshalEasyJSON(in)
+		case "sourceA":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.SourceA = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v111 interface{}
+					if m, ok := v111.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v111.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v111 = in.Interface()
+					}
+					(out.SourceA)[key] = v111
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "sourceB":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.SourceB = make(map[string]interface{})
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v112 interface{}
+					if m, ok := v112.(easyjson.Unmarshaler); ok {
+						m.UnmarshalEasyJSON(in)
+					} else if m, ok := v112.(json.Unmarshaler); ok {
+						_ = m.UnmarshalJSON(in.Raw())
+					} else {
+						v112 = in.Interface()
+					}
+					(out.SourceB)[key] = v112
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "matches":
+			(out.Matches).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels77(out *jwriter.Writer, in PlagiarismView) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pair\":"
+		out.RawString(prefix[1:])
+		(in.Pair).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"sourceA\":"
+		out.RawString(prefix)
+		if in.SourceA == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v113First := true
+			for v113Name, v113Value := range in.SourceA {
+				if v113First {
+					v113First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v113Name))
+				out.RawByte(':')
+				if m, ok := v113Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v113Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v113Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"sourceB\":"
+		out.RawString(prefix)
+		if in.SourceB == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v114First := true
+			for v114Name, v114Value := range in.SourceB {
+				if v114First {
+					v114First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v114Name))
+				out.RawByte(':')
+				if m, ok := v114Value.(easyjson.Marshaler); ok {
+					m.MarshalEasyJSON(out)
+				} else if m, ok := v114Value.(json.Marshaler); ok {
+					out.Raw(m.MarshalJSON())
+				} else {
+					out.Raw(json.Marshal(v114Value))
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"matches\":"
+		out.RawString(prefix)
+		(in.Matches).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlagiarismView) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels77(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlagiarismView) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels77(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlagiarismView) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels77(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlagiarismView) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels77(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels78(in *jlexer.Lexer, out *PlagiarismReport) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "taskId":
+			out.TaskId = uint64(in.Uint64())
+		case "status":
+			out.Status = string(in.String())
+		case "solutions":
+			out.Solutions = int(in.Int())
+		case "pairs":
+			out.Pairs = int(in.Int())
+		case "error":
+			out.Error = string(in.String())
+		case "requestedDatetime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.RequestedDateTime).UnmarshalJSON(data))
+			}
+		case "checkedDatetime":
+			if in.IsNull() {
+				in.Skip()
+				out.CheckedDateTime = nil
+			} else {
+				if out.CheckedDateTime == nil {
+					out.CheckedDateTime = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CheckedDateTime).UnmarshalJSON(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -960,82 +8135,204 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels10(in *jlexer.Lexer, out *T
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels10(out *jwriter.Writer, in TaskNew) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels78(out *jwriter.Writer, in PlagiarismReport) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"taskId\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Title))
+		out.Uint64(uint64(in.TaskId))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.String(string(in.Status))
 	}
 	{
-		const prefix string = ",\"stdinDescription\":"
+		const prefix string = ",\"solutions\":"
 		out.RawString(prefix)
-		out.String(string(in.Input))
+		out.Int(int(in.Solutions))
 	}
 	{
-		const prefix string = ",\"stdoutDescription\":"
+		const prefix string = ",\"pairs\":"
 		out.RawString(prefix)
-		out.String(string(in.Output))
+		out.Int(int(in.Pairs))
 	}
-	{
-		const prefix string = ",\"hints\":"
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
 		out.RawString(prefix)
-		out.String(string(in.Hints))
+		out.String(string(in.Error))
 	}
 	{
-		const prefix string = ",\"tests\":"
+		const prefix string = ",\"requestedDatetime\":"
 		out.RawString(prefix)
-		(in.Tests).MarshalEasyJSON(out)
+		out.Raw((in.RequestedDateTime).MarshalJSON())
 	}
-	{
-		const prefix string = ",\"creator\":"
+	if in.CheckedDateTime != nil {
+		const prefix string = ",\"checkedDatetime\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Creator))
+		out.Raw((*in.CheckedDateTime).MarshalJSON())
 	}
-	{
-		const prefix string = ",\"is_private\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsPrivate))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlagiarismReport) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels78(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlagiarismReport) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels78(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlagiarismReport) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels78(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlagiarismReport) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels78(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels79(in *jlexer.Lexer, out *PlagiarismPairsSQL) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(PlagiarismPairsSQL, 0, 0)
+			} else {
+				*out = PlagiarismPairsSQL{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v115 PlagiarismPairSQL
+			(v115).UnmarshalEasyJSON(in)
+			*out = append(*out, v115)
+			in.WantComma()
+		}
+		in.Delim(']')
 	}
-	{
-		const prefix string = ",\"code\":"
-		out.RawString(prefix)
-		out.String(string(in.Code))
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels79(out *jwriter.Writer, in PlagiarismPairsSQL) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v116, v117 := range in {
+			if v116 > 0 {
+				out.RawByte(',')
+			}
+			(v117).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
 	}
-	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TaskNew) MarshalJSON() ([]byte, error) {
+func (v PlagiarismPairsSQL) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels10(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels79(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TaskNew) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels10(w, v)
+func (v PlagiarismPairsSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels79(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TaskNew) UnmarshalJSON(data []byte) error {
+func (v *PlagiarismPairsSQL) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels10(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels79(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TaskNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels10(l, v)
+func (v *PlagiarismPairsSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels79(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels80(in *jlexer.Lexer, out *PlagiarismPairs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(PlagiarismPairs, 0, 0)
+			} else {
+				*out = PlagiarismPairs{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v118 PlagiarismPair
+			(v118).UnmarshalEasyJSON(in)
+			*out = append(*out, v118)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels80(out *jwriter.Writer, in PlagiarismPairs) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v119, v120 := range in {
+			if v119 > 0 {
+				out.RawByte(',')
+			}
+			(v120).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlagiarismPairs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels80(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlagiarismPairs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels80(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlagiarismPairs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels80(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlagiarismPairs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels80(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels11(in *jlexer.Lexer, out *Task) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels81(in *jlexer.Lexer, out *PlagiarismPairSQL) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1054,28 +8351,32 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels11(in *jlexer.Lexer, out *T
 			continue
 		}
 		switch key {
-		case "id":
+		case "Id":
 			out.Id = uint64(in.Uint64())
-		case "name":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "stdinDescription":
-			out.Input = string(in.String())
-		case "stdoutDescription":
-			out.Output = string(in.String())
-		case "hints":
-			out.Hints = string(in.String())
-		case "testsAmount":
-			out.TestsAmount = int(in.Int())
-		case "tests":
-			(out.Tests).UnmarshalEasyJSON(in)
-		case "author":
-			out.Author = string(in.String())
-		case "authorId":
-			out.AuthorId = string(in.String())
-		case "isCleared":
-			out.IsCleared = bool(in.Bool())
+		case "TaskId":
+			out.TaskId = uint64(in.Uint64())
+		case "Language":
+			out.Language = string(in.String())
+		case "SolutionA":
+			out.SolutionA = uint64(in.Uint64())
+		case "UidA":
+			out.UidA = uint64(in.Uint64())
+		case "UsernameA":
+			out.UsernameA = string(in.String())
+		case "SolutionB":
+			out.SolutionB = uint64(in.Uint64())
+		case "UidB":
+			out.UidB = uint64(in.Uint64())
+		case "UsernameB":
+			out.UsernameB = string(in.String())
+		case "Score":
+			out.Score = float64(in.Float64())
+		case "Matches":
+			out.Matches = string(in.String())
+		case "CheckedDateTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CheckedDateTime).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1086,158 +8387,235 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels11(in *jlexer.Lexer, out *T
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels11(out *jwriter.Writer, in Task) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels81(out *jwriter.Writer, in PlagiarismPairSQL) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"Id\":"
 		out.RawString(prefix[1:])
 		out.Uint64(uint64(in.Id))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"TaskId\":"
 		out.RawString(prefix)
-		out.String(string(in.Title))
+		out.Uint64(uint64(in.TaskId))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"Language\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.String(string(in.Language))
 	}
 	{
-		const prefix string = ",\"stdinDescription\":"
+		const prefix string = ",\"SolutionA\":"
 		out.RawString(prefix)
-		out.String(string(in.Input))
+		out.Uint64(uint64(in.SolutionA))
 	}
 	{
-		const prefix string = ",\"stdoutDescription\":"
+		const prefix string = ",\"UidA\":"
 		out.RawString(prefix)
-		out.String(string(in.Output))
+		out.Uint64(uint64(in.UidA))
 	}
 	{
-		const prefix string = ",\"hints\":"
+		const prefix string = ",\"UsernameA\":"
 		out.RawString(prefix)
-		out.String(string(in.Hints))
+		out.String(string(in.UsernameA))
 	}
 	{
-		const prefix string = ",\"testsAmount\":"
+		const prefix string = ",\"SolutionB\":"
 		out.RawString(prefix)
-		out.Int(int(in.TestsAmount))
+		out.Uint64(uint64(in.SolutionB))
 	}
 	{
-		const prefix string = ",\"tests\":"
+		const prefix string = ",\"UidB\":"
 		out.RawString(prefix)
-		(in.Tests).MarshalEasyJSON(out)
+		out.Uint64(uint64(in.UidB))
 	}
 	{
-		const prefix string = ",\"author\":"
+		const prefix string = ",\"UsernameB\":"
 		out.RawString(prefix)
-		out.String(string(in.Author))
+		out.String(string(in.UsernameB))
 	}
 	{
-		const prefix string = ",\"authorId\":"
+		const prefix string = ",\"Score\":"
 		out.RawString(prefix)
-		out.String(string(in.AuthorId))
+		out.Float64(float64(in.Score))
 	}
 	{
-		const prefix string = ",\"isCleared\":"
+		const prefix string = ",\"Matches\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsCleared))
+		out.String(string(in.Matches))
+	}
+	{
+		const prefix string = ",\"CheckedDateTime\":"
+		out.RawString(prefix)
+		out.Raw((in.CheckedDateTime).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Task) MarshalJSON() ([]byte, error) {
+func (v PlagiarismPairSQL) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels11(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels81(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Task) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels11(w, v)
+func (v PlagiarismPairSQL) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels81(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Task) UnmarshalJSON(data []byte) error {
+func (v *PlagiarismPairSQL) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels11(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels81(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Task) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels11(l, v)
+func (v *PlagiarismPairSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels81(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels12(in *jlexer.Lexer, out *SolutionsSQL) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels82(in *jlexer.Lexer, out *PlagiarismPair) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
-		in.Skip()
-		*out = nil
-	} else {
-		in.Delim('[')
-		if *out == nil {
-			if !in.IsDelim(']') {
-				*out = make(SolutionsSQL, 0, 0)
-			} else {
-				*out = SolutionsSQL{}
-			}
-		} else {
-			*out = (*out)[:0]
+		if isTopLevel {
+			in.Consumed()
 		}
-		for !in.IsDelim(']') {
-			var v13 SolutionSQL
-			(v13).UnmarshalEasyJSON(in)
-			*out = append(*out, v13)
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
 			in.WantComma()
+			continue
 		}
-		in.Delim(']')
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "taskId":
+			out.TaskId = uint64(in.Uint64())
+		case "language":
+			out.Language = string(in.String())
+		case "solutionA":
+			out.SolutionA = uint64(in.Uint64())
+		case "uidA":
+			out.UidA = uint64(in.Uint64())
+		case "usernameA":
+			out.UsernameA = string(in.String())
+		case "solutionB":
+			out.SolutionB = uint64(in.Uint64())
+		case "uidB":
+			out.UidB = uint64(in.Uint64())
+		case "usernameB":
+			out.UsernameB = string(in.String())
+		case "score":
+			out.Score = float64(in.Float64())
+		case "checkedDatetime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CheckedDateTime).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels82(out *jwriter.Writer, in PlagiarismPair) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"taskId\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.TaskId))
+	}
+	{
+		const prefix string = ",\"language\":"
+		out.RawString(prefix)
+		out.String(string(in.Language))
+	}
+	{
+		const prefix string = ",\"solutionA\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.SolutionA))
 	}
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"uidA\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UidA))
 	}
-}
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels12(out *jwriter.Writer, in SolutionsSQL) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v14, v15 := range in {
-			if v14 > 0 {
-				out.RawByte(',')
-			}
-			(v15).MarshalEasyJSON(out)
-		}
-		out.RawByte(']')
+	{
+		const prefix string = ",\"usernameA\":"
+		out.RawString(prefix)
+		out.String(string(in.UsernameA))
+	}
+	{
+		const prefix string = ",\"solutionB\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.SolutionB))
+	}
+	{
+		const prefix string = ",\"uidB\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.UidB))
+	}
+	{
+		const prefix string = ",\"usernameB\":"
+		out.RawString(prefix)
+		out.String(string(in.UsernameB))
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Score))
 	}
+	{
+		const prefix string = ",\"checkedDatetime\":"
+		out.RawString(prefix)
+		out.Raw((in.CheckedDateTime).MarshalJSON())
+	}
+	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SolutionsSQL) MarshalJSON() ([]byte, error) {
+func (v PlagiarismPair) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels12(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels82(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SolutionsSQL) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels12(w, v)
+func (v PlagiarismPair) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels82(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SolutionsSQL) UnmarshalJSON(data []byte) error {
+func (v *PlagiarismPair) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels12(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels82(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SolutionsSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels12(l, v)
+func (v *PlagiarismPair) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels82(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels13(in *jlexer.Lexer, out *Solutions) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels83(in *jlexer.Lexer, out *PlagiarismMatches) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -1246,17 +8624,17 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels13(in *jlexer.Lexer, out *S
 		in.Delim('[')
 		if *out == nil {
 			if !in.IsDelim(']') {
-				*out = make(Solutions, 0, 0)
+				*out = make(PlagiarismMatches, 0, 1)
 			} else {
-				*out = Solutions{}
+				*out = PlagiarismMatches{}
 			}
 		} else {
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v16 SolutionOne
-			(v16).UnmarshalEasyJSON(in)
-			*out = append(*out, v16)
+			var v121 PlagiarismMatch
+			(v121).UnmarshalEasyJSON(in)
+			*out = append(*out, v121)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -1265,45 +8643,45 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels13(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels13(out *jwriter.Writer, in Solutions) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels83(out *jwriter.Writer, in PlagiarismMatches) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v17, v18 := range in {
-			if v17 > 0 {
+		for v122, v123 := range in {
+			if v122 > 0 {
 				out.RawByte(',')
 			}
-			(v18).MarshalEasyJSON(out)
+			(v123).MarshalEasyJSON(out)
 		}
 		out.RawByte(']')
 	}
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Solutions) MarshalJSON() ([]byte, error) {
+func (v PlagiarismMatches) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels13(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels83(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Solutions) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels13(w, v)
+func (v PlagiarismMatches) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels83(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Solutions) UnmarshalJSON(data []byte) error {
+func (v *PlagiarismMatches) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels13(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels83(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Solutions) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels13(l, v)
+func (v *PlagiarismMatches) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels83(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels14(in *jlexer.Lexer, out *SolutionUpdate) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels84(in *jlexer.Lexer, out *PlagiarismMatch) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1322,22 +8700,18 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels14(in *jlexer.Lexer, out *S
 			continue
 		}
 		switch key {
-		case "checkResult":
-			out.Code = int(in.Int())
-		case "checkMessage":
-			out.CheckMessage = string(in.String())
-		case "checkedDatetime":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CheckedDateTime).UnmarshalJSON(data))
-			}
-		case "checkTime":
-			out.CheckTime = float32(in.Float32())
-		case "buildTime":
-			out.CompileTime = float32(in.Float32())
-		case "testsPassed":
-			out.Passed = int(in.Int())
-		case "testsTotal":
-			out.TestsTotal = int(in.Int())
+		case "fileA":
+			out.FileA = string(in.String())
+		case "startA":
+			out.StartA = int(in.Int())
+		case "endA":
+			out.EndA = int(in.Int())
+		case "fileB":
+			out.FileB = string(in.String())
+		case "startB":
+			out.StartB = int(in.Int())
+		case "endB":
+			out.EndB = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1348,72 +8722,67 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels14(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels14(out *jwriter.Writer, in SolutionUpdate) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels84(out *jwriter.Writer, in PlagiarismMatch) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"checkResult\":"
+		const prefix string = ",\"fileA\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Code))
-	}
-	{
-		const prefix string = ",\"checkMessage\":"
-		out.RawString(prefix)
-		out.String(string(in.CheckMessage))
+		out.String(string(in.FileA))
 	}
 	{
-		const prefix string = ",\"checkedDatetime\":"
+		const prefix string = ",\"startA\":"
 		out.RawString(prefix)
-		out.Raw((in.CheckedDateTime).MarshalJSON())
+		out.Int(int(in.StartA))
 	}
 	{
-		const prefix string = ",\"checkTime\":"
+		const prefix string = ",\"endA\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.CheckTime))
+		out.Int(int(in.EndA))
 	}
 	{
-		const prefix string = ",\"buildTime\":"
+		const prefix string = ",\"fileB\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.CompileTime))
+		out.String(string(in.FileB))
 	}
 	{
-		const prefix string = ",\"testsPassed\":"
+		const prefix string = ",\"startB\":"
 		out.RawString(prefix)
-		out.Int(int(in.Passed))
+		out.Int(int(in.StartB))
 	}
 	{
-		const prefix string = ",\"testsTotal\":"
+		const prefix string = ",\"endB\":"
 		out.RawString(prefix)
-		out.Int(int(in.TestsTotal))
+		out.Int(int(in.EndB))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SolutionUpdate) MarshalJSON() ([]byte, error) {
+func (v PlagiarismMatch) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels14(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels84(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SolutionUpdate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels14(w, v)
+func (v PlagiarismMatch) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels84(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SolutionUpdate) UnmarshalJSON(data []byte) error {
+func (v *PlagiarismMatch) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels14(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels84(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SolutionUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels14(l, v)
+func (v *PlagiarismMatch) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels84(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels15(in *jlexer.Lexer, out *SolutionSend) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels85(in *jlexer.Lexer, out *PasswordNew) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1432,32 +8801,10 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels15(in *jlexer.Lexer, out *S
 			continue
 		}
 		switch key {
-		case "id":
-			out.Id = uint64(in.Uint64())
-		case "sourceCode":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				out.SourceCode = make(map[string]interface{})
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v19 interface{}
-					if m, ok := v19.(easyjson.Unmarshaler); ok {
-						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v19.(json.Unmarshaler); ok {
-						_ = m.UnmarshalJSON(in.Raw())
-					} else {
-						v19 = in.Interface()
-					}
-					(out.SourceCode)[key] = v19
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
-		case "tests":
-			(out.Tests).UnmarshalEasyJSON(in)
+		case "oldPassword":
+			out.Old = string(in.String())
+		case "newPassword":
+			out.New = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1468,74 +8815,47 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels15(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels15(out *jwriter.Writer, in SolutionSend) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels85(out *jwriter.Writer, in PasswordNew) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"oldPassword\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Id))
-	}
-	{
-		const prefix string = ",\"sourceCode\":"
-		out.RawString(prefix)
-		if in.SourceCode == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v20First := true
-			for v20Name, v20Value := range in.SourceCode {
-				if v20First {
-					v20First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v20Name))
-				out.RawByte(':')
-				if m, ok := v20Value.(easyjson.Marshaler); ok {
-					m.MarshalEasyJSON(out)
-				} else if m, ok := v20Value.(json.Marshaler); ok {
-					out.Raw(m.MarshalJSON())
-				} else {
-					out.Raw(json.Marshal(v20Value))
-				}
-			}
-			out.RawByte('}')
-		}
+		out.String(string(in.Old))
 	}
 	{
-		const prefix string = ",\"tests\":"
+		const prefix string = ",\"newPassword\":"
 		out.RawString(prefix)
-		(in.Tests).MarshalEasyJSON(out)
+		out.String(string(in.New))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SolutionSend) MarshalJSON() ([]byte, error) {
+func (v PasswordNew) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels15(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SolutionSend) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels15(w, v)
+func (v PasswordNew) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SolutionSend) UnmarshalJSON(data []byte) error {
+func (v *PasswordNew) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels15(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SolutionSend) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels15(l, v)
+func (v *PasswordNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels85(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels16(in *jlexer.Lexer, out *SolutionSQL) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels86(in *jlexer.Lexer, out *Pases) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1554,34 +8874,8 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels16(in *jlexer.Lexer, out *S
 			continue
 		}
 		switch key {
-		case "Id":
-			out.Id = uint64(in.Uint64())
-		case "ReceivedDateTime":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ReceivedDateTime).UnmarshalJSON(data))
-			}
-		case "CheckedDateTime":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CheckedDateTime).UnmarshalJSON(data))
-			}
-		case "SourceCode":
-			out.SourceCode = string(in.String())
-		case "TaskId":
-			out.TaskId = uint64(in.Uint64())
-		case "CheckResult":
-			out.CheckResult = int(in.Int())
-		case "CheckTime":
-			out.CheckTime = float32(in.Float32())
-		case "CompileTime":
-			out.CompileTime = float32(in.Float32())
-		case "CheckMessage":
-			out.CheckMessage = string(in.String())
-		case "TestsPassed":
-			out.TestsPassed = int(in.Int())
-		case "TestsTotal":
-			out.TestsTotal = int(in.Int())
-		case "Uid":
-			out.Uid = uint64(in.Uint64())
+		case "count":
+			out.Count = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1592,97 +8886,108 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels16(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels16(out *jwriter.Writer, in SolutionSQL) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels86(out *jwriter.Writer, in Pases) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Id\":"
+		const prefix string = ",\"count\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Id))
-	}
-	{
-		const prefix string = ",\"ReceivedDateTime\":"
-		out.RawString(prefix)
-		out.Raw((in.ReceivedDateTime).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"CheckedDateTime\":"
-		out.RawString(prefix)
-		out.Raw((in.CheckedDateTime).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"SourceCode\":"
-		out.RawString(prefix)
-		out.String(string(in.SourceCode))
-	}
-	{
-		const prefix string = ",\"TaskId\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.TaskId))
-	}
-	{
-		const prefix string = ",\"CheckResult\":"
-		out.RawString(prefix)
-		out.Int(int(in.CheckResult))
-	}
-	{
-		const prefix string = ",\"CheckTime\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.CheckTime))
-	}
-	{
-		const prefix string = ",\"CompileTime\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.CompileTime))
-	}
-	{
-		const prefix string = ",\"CheckMessage\":"
-		out.RawString(prefix)
-		out.String(string(in.CheckMessage))
+		out.Int(int(in.Count))
 	}
-	{
-		const prefix string = ",\"TestsPassed\":"
-		out.RawString(prefix)
-		out.Int(int(in.TestsPassed))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Pases) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels86(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Pases) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels86(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Pases) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels86(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Pases) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels86(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels87(in *jlexer.Lexer, out *Languages) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(Languages, 0, 0)
+			} else {
+				*out = Languages{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v124 Language
+			(v124).UnmarshalEasyJSON(in)
+			*out = append(*out, v124)
+			in.WantComma()
+		}
+		in.Delim(']')
 	}
-	{
-		const prefix string = ",\"TestsTotal\":"
-		out.RawString(prefix)
-		out.Int(int(in.TestsTotal))
+	if isTopLevel {
+		in.Consumed()
 	}
-	{
-		const prefix string = ",\"Uid\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.Uid))
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels87(out *jwriter.Writer, in Languages) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v125, v126 := range in {
+			if v125 > 0 {
+				out.RawByte(',')
+			}
+			(v126).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
 	}
-	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SolutionSQL) MarshalJSON() ([]byte, error) {
+func (v Languages) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels16(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels87(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SolutionSQL) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels16(w, v)
+func (v Languages) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels87(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SolutionSQL) UnmarshalJSON(data []byte) error {
+func (v *Languages) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels16(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels87(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SolutionSQL) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels16(l, v)
+func (v *Languages) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels87(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels17(in *jlexer.Lexer, out *SolutionOne) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels88(in *jlexer.Lexer, out *Language) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1702,29 +9007,36 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels17(in *jlexer.Lexer, out *S
 		}
 		switch key {
 		case "id":
-			out.Id = uint64(in.Uint64())
-		case "sourceCode":
-			out.SourceCode = string(in.String())
-		case "receivedDatetime":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ReceivedDateTime).UnmarshalJSON(data))
-			}
-		case "checkedDatetime":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CheckedDateTime).UnmarshalJSON(data))
+			out.Id = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "compile":
+			out.Compile = string(in.String())
+		case "run":
+			out.Run = string(in.String())
+		case "extensions":
+			if in.IsNull() {
+				in.Skip()
+				out.Extensions = nil
+			} else {
+				in.Delim('[')
+				if out.Extensions == nil {
+					if !in.IsDelim(']') {
+						out.Extensions = make([]string, 0, 4)
+					} else {
+						out.Extensions = []string{}
+					}
+				} else {
+					out.Extensions = (out.Extensions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v127 string
+					v127 = string(in.String())
+					out.Extensions = append(out.Extensions, v127)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "checkResult":
-			out.CheckResult = int(in.Int())
-		case "checkTime":
-			out.CheckTime = float32(in.Float32())
-		case "checkMessage":
-			out.CheckMessage = string(in.String())
-		case "compileTime":
-			out.CompileTime = float32(in.Float32())
-		case "testsPassed":
-			out.TestsPassed = int(in.Int())
-		case "testsTotal":
-			out.TestsTotal = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1735,87 +9047,73 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels17(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels17(out *jwriter.Writer, in SolutionOne) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels88(out *jwriter.Writer, in Language) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Id))
-	}
-	{
-		const prefix string = ",\"sourceCode\":"
-		out.RawString(prefix)
-		out.String(string(in.SourceCode))
-	}
-	{
-		const prefix string = ",\"receivedDatetime\":"
-		out.RawString(prefix)
-		out.Raw((in.ReceivedDateTime).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"checkedDatetime\":"
-		out.RawString(prefix)
-		out.Raw((in.CheckedDateTime).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"checkResult\":"
-		out.RawString(prefix)
-		out.Int(int(in.CheckResult))
-	}
-	{
-		const prefix string = ",\"checkTime\":"
-		out.RawString(prefix)
-		out.Float32(float32(in.CheckTime))
+		out.String(string(in.Id))
 	}
 	{
-		const prefix string = ",\"checkMessage\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.CheckMessage))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"compileTime\":"
+		const prefix string = ",\"compile\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.CompileTime))
+		out.String(string(in.Compile))
 	}
 	{
-		const prefix string = ",\"testsPassed\":"
+		const prefix string = ",\"run\":"
 		out.RawString(prefix)
-		out.Int(int(in.TestsPassed))
+		out.String(string(in.Run))
 	}
 	{
-		const prefix string = ",\"testsTotal\":"
+		const prefix string = ",\"extensions\":"
 		out.RawString(prefix)
-		out.Int(int(in.TestsTotal))
+		if in.Extensions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v128, v129 := range in.Extensions {
+				if v128 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v129))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SolutionOne) MarshalJSON() ([]byte, error) {
+func (v Language) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels17(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels88(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SolutionOne) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels17(w, v)
+func (v Language) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels88(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SolutionOne) UnmarshalJSON(data []byte) error {
+func (v *Language) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels17(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels88(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SolutionOne) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels17(l, v)
+func (v *Language) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels88(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels18(in *jlexer.Lexer, out *SolutionFull) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels89(in *jlexer.Lexer, out *JudgeJob) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1834,52 +9132,18 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels18(in *jlexer.Lexer, out *S
 			continue
 		}
 		switch key {
-		case "id":
-			out.Id = uint64(in.Uint64())
-		case "sourceCode":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				out.SourceCode = make(map[string]interface{})
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v21 interface{}
-					if m, ok := v21.(easyjson.Unmarshaler); ok {
-						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v21.(json.Unmarshaler); ok {
-						_ = m.UnmarshalJSON(in.Raw())
-					} else {
-						v21 = in.Interface()
-					}
-					(out.SourceCode)[key] = v21
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
-		case "receivedDatetime":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ReceivedDateTime).UnmarshalJSON(data))
-			}
-		case "checkedDatetime":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CheckedDateTime).UnmarshalJSON(data))
-			}
-		case "checkResult":
-			out.CheckResult = int(in.Int())
-		case "checkMessage":
-			out.CheckMessage = string(in.String())
-		case "checkTime":
-			out.CheckTime = float32(in.Float32())
-		case "compileTime":
-			out.CompileTime = float32(in.Float32())
-		case "tests":
-			(out.Tests).UnmarshalEasyJSON(in)
-		case "testsPassed":
-			out.TestsPassed = int(in.Int())
-		case "testsTotal":
-			out.TestsTotal = int(in.Int())
+		case "solutionId":
+			out.SolutionId = uint64(in.Uint64())
+		case "taskId":
+			out.TaskId = uint64(in.Uint64())
+		case "uid":
+			out.Uid = uint64(in.Uint64())
+		case "attempts":
+			out.Attempts = int(in.Int())
+		case "rejudge":
+			out.Rejudge = bool(in.Bool())
+		case "error":
+			out.Error = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1890,114 +9154,165 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels18(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels18(out *jwriter.Writer, in SolutionFull) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels89(out *jwriter.Writer, in JudgeJob) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"solutionId\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Id))
-	}
-	{
-		const prefix string = ",\"sourceCode\":"
-		out.RawString(prefix)
-		if in.SourceCode == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v22First := true
-			for v22Name, v22Value := range in.SourceCode {
-				if v22First {
-					v22First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v22Name))
-				out.RawByte(':')
-				if m, ok := v22Value.(easyjson.Marshaler); ok {
-					m.MarshalEasyJSON(out)
-				} else if m, ok := v22Value.(json.Marshaler); ok {
-					out.Raw(m.MarshalJSON())
-				} else {
-					out.Raw(json.Marshal(v22Value))
-				}
-			}
-			out.RawByte('}')
-		}
-	}
-	{
-		const prefix string = ",\"receivedDatetime\":"
-		out.RawString(prefix)
-		out.Raw((in.ReceivedDateTime).MarshalJSON())
+		out.Uint64(uint64(in.SolutionId))
 	}
 	{
-		const prefix string = ",\"checkedDatetime\":"
+		const prefix string = ",\"taskId\":"
 		out.RawString(prefix)
-		out.Raw((in.CheckedDateTime).MarshalJSON())
+		out.Uint64(uint64(in.TaskId))
 	}
 	{
-		const prefix string = ",\"checkResult\":"
+		const prefix string = ",\"uid\":"
 		out.RawString(prefix)
-		out.Int(int(in.CheckResult))
+		out.Uint64(uint64(in.Uid))
 	}
 	{
-		const prefix string = ",\"checkMessage\":"
+		const prefix string = ",\"attempts\":"
 		out.RawString(prefix)
-		out.String(string(in.CheckMessage))
+		out.Int(int(in.Attempts))
 	}
-	{
-		const prefix string = ",\"checkTime\":"
+	if in.Rejudge {
+		const prefix string = ",\"rejudge\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.CheckTime))
+		out.Bool(bool(in.Rejudge))
 	}
-	{
-		const prefix string = ",\"compileTime\":"
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
 		out.RawString(prefix)
-		out.Float32(float32(in.CompileTime))
+		out.String(string(in.Error))
 	}
-	{
-		const prefix string = ",\"tests\":"
-		out.RawString(prefix)
-		(in.Tests).MarshalEasyJSON(out)
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JudgeJob) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels89(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JudgeJob) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels89(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JudgeJob) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels89(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JudgeJob) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels89(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels90(in *jlexer.Lexer, out *InputTests) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(InputTests, 0, 2)
+			} else {
+				*out = InputTests{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v130 []string
+			if in.IsNull() {
+				in.Skip()
+				v130 = nil
+			} else {
+				in.Delim('[')
+				if v130 == nil {
+					if !in.IsDelim(']') {
+						v130 = make([]string, 0, 4)
+					} else {
+						v130 = []string{}
+					}
+				} else {
+					v130 = (v130)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v131 string
+					v131 = string(in.String())
+					v130 = append(v130, v131)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+			*out = append(*out, v130)
+			in.WantComma()
+		}
+		in.Delim(']')
 	}
-	{
-		const prefix string = ",\"testsPassed\":"
-		out.RawString(prefix)
-		out.Int(int(in.TestsPassed))
+	if isTopLevel {
+		in.Consumed()
 	}
-	{
-		const prefix string = ",\"testsTotal\":"
-		out.RawString(prefix)
-		out.Int(int(in.TestsTotal))
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels90(out *jwriter.Writer, in InputTests) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v132, v133 := range in {
+			if v132 > 0 {
+				out.RawByte(',')
+			}
+			if v133 == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+				out.RawString("null")
+			} else {
+				out.RawByte('[')
+				for v134, v135 := range v133 {
+					if v134 > 0 {
+						out.RawByte(',')
+					}
+					out.String(string(v135))
+				}
+				out.RawByte(']')
+			}
+		}
+		out.RawByte(']')
 	}
-	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SolutionFull) MarshalJSON() ([]byte, error) {
+func (v InputTests) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels18(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels90(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SolutionFull) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels18(w, v)
+func (v InputTests) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels90(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SolutionFull) UnmarshalJSON(data []byte) error {
+func (v *InputTests) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels18(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels90(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SolutionFull) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels18(l, v)
+func (v *InputTests) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels90(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels19(in *jlexer.Lexer, out *SolutionFile) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels91(in *jlexer.Lexer, out *IdValue) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2016,8 +9331,8 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels19(in *jlexer.Lexer, out *S
 			continue
 		}
 		switch key {
-		case "sourceCode":
-			out.SourceCode = string(in.String())
+		case "id":
+			out.Id = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2028,42 +9343,42 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels19(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels19(out *jwriter.Writer, in SolutionFile) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels91(out *jwriter.Writer, in IdValue) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"sourceCode\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.SourceCode))
+		out.String(string(in.Id))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SolutionFile) MarshalJSON() ([]byte, error) {
+func (v IdValue) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels19(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels91(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SolutionFile) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels19(w, v)
+func (v IdValue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels91(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SolutionFile) UnmarshalJSON(data []byte) error {
+func (v *IdValue) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels19(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels91(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SolutionFile) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels19(l, v)
+func (v *IdValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels91(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels20(in *jlexer.Lexer, out *Solution) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels92(in *jlexer.Lexer, out *GeneratorStep) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2082,28 +9397,14 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels20(in *jlexer.Lexer, out *S
 			continue
 		}
 		switch key {
-		case "sourceCode":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				out.SourceCode = make(map[string]interface{})
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v23 interface{}
-					if m, ok := v23.(easyjson.Unmarshaler); ok {
-						m.UnmarshalEasyJSON(in)
-					} else if m, ok := v23.(json.Unmarshaler); ok {
-						_ = m.UnmarshalJSON(in.Raw())
-					} else {
-						v23 = in.Interface()
-					}
-					(out.SourceCode)[key] = v23
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
+		case "args":
+			out.Args = string(in.String())
+		case "sample":
+			out.Sample = bool(in.Bool())
+		case "hidden":
+			out.Hidden = bool(in.Bool())
+		case "note":
+			out.Note = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2114,64 +9415,123 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels20(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels20(out *jwriter.Writer, in Solution) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels92(out *jwriter.Writer, in GeneratorStep) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"sourceCode\":"
+		const prefix string = ",\"args\":"
 		out.RawString(prefix[1:])
-		if in.SourceCode == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
+		out.String(string(in.Args))
+	}
+	if in.Sample {
+		const prefix string = ",\"sample\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Sample))
+	}
+	if in.Hidden {
+		const prefix string = ",\"hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Hidden))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GeneratorStep) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels92(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GeneratorStep) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels92(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GeneratorStep) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels92(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GeneratorStep) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels92(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels93(in *jlexer.Lexer, out *GeneratorScript) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(GeneratorScript, 0, 1)
+			} else {
+				*out = GeneratorScript{}
+			}
 		} else {
-			out.RawByte('{')
-			v24First := true
-			for v24Name, v24Value := range in.SourceCode {
-				if v24First {
-					v24First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v24Name))
-				out.RawByte(':')
-				if m, ok := v24Value.(easyjson.Marshaler); ok {
-					m.MarshalEasyJSON(out)
-				} else if m, ok := v24Value.(json.Marshaler); ok {
-					out.Raw(m.MarshalJSON())
-				} else {
-					out.Raw(json.Marshal(v24Value))
-				}
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v136 GeneratorStep
+			(v136).UnmarshalEasyJSON(in)
+			*out = append(*out, v136)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels93(out *jwriter.Writer, in GeneratorScript) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v137, v138 := range in {
+			if v137 > 0 {
+				out.RawByte(',')
 			}
-			out.RawByte('}')
+			(v138).MarshalEasyJSON(out)
 		}
+		out.RawByte(']')
 	}
-	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Solution) MarshalJSON() ([]byte, error) {
+func (v GeneratorScript) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels20(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels93(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Solution) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels20(w, v)
+func (v GeneratorScript) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels93(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Solution) UnmarshalJSON(data []byte) error {
+func (v *GeneratorScript) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels20(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels93(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Solution) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels20(l, v)
+func (v *GeneratorScript) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels93(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels21(in *jlexer.Lexer, out *ShortTasks) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels94(in *jlexer.Lexer, out *FileDiffs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -2180,17 +9540,17 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels21(in *jlexer.Lexer, out *S
 		in.Delim('[')
 		if *out == nil {
 			if !in.IsDelim(']') {
-				*out = make(ShortTasks, 0, 0)
+				*out = make(FileDiffs, 0, 0)
 			} else {
-				*out = ShortTasks{}
+				*out = FileDiffs{}
 			}
 		} else {
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v25 ShortTask
-			(v25).UnmarshalEasyJSON(in)
-			*out = append(*out, v25)
+			var v139 FileDiff
+			(v139).UnmarshalEasyJSON(in)
+			*out = append(*out, v139)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -2199,45 +9559,45 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels21(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels21(out *jwriter.Writer, in ShortTasks) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels94(out *jwriter.Writer, in FileDiffs) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v26, v27 := range in {
-			if v26 > 0 {
+		for v140, v141 := range in {
+			if v140 > 0 {
 				out.RawByte(',')
 			}
-			(v27).MarshalEasyJSON(out)
+			(v141).MarshalEasyJSON(out)
 		}
 		out.RawByte(']')
 	}
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ShortTasks) MarshalJSON() ([]byte, error) {
+func (v FileDiffs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels21(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels94(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ShortTasks) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels21(w, v)
+func (v FileDiffs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels94(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ShortTasks) UnmarshalJSON(data []byte) error {
+func (v *FileDiffs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels21(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels94(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ShortTasks) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels21(l, v)
+func (v *FileDiffs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels94(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels22(in *jlexer.Lexer, out *ShortTask) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels95(in *jlexer.Lexer, out *FileDiff) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2256,20 +9616,14 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels22(in *jlexer.Lexer, out *S
 			continue
 		}
 		switch key {
-		case "id":
-			out.Id = uint64(in.Uint64())
 		case "name":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "testsAmount":
-			out.TestAmount = int(in.Int())
-		case "isCleared":
-			out.IsCleared = bool(in.Bool())
-		case "creator":
-			out.Creator = string(in.String())
-		case "creatorId":
-			out.CreatorId = uint64(in.Uint64())
+			out.Name = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "unified":
+			out.Unified = string(in.String())
+		case "hunks":
+			(out.Hunks).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -2280,72 +9634,123 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels22(in *jlexer.Lexer, out *S
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels22(out *jwriter.Writer, in ShortTask) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels95(out *jwriter.Writer, in FileDiff) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Id))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.String(string(in.Title))
+		out.String(string(in.Status))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"unified\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.String(string(in.Unified))
 	}
 	{
-		const prefix string = ",\"testsAmount\":"
+		const prefix string = ",\"hunks\":"
 		out.RawString(prefix)
-		out.Int(int(in.TestAmount))
+		(in.Hunks).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"isCleared\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsCleared))
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FileDiff) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels95(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FileDiff) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels95(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FileDiff) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels95(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FileDiff) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels95(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels96(in *jlexer.Lexer, out *DifficultyCounts) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(DifficultyCounts, 0, 4)
+			} else {
+				*out = DifficultyCounts{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v142 DifficultyCount
+			(v142).UnmarshalEasyJSON(in)
+			*out = append(*out, v142)
+			in.WantComma()
+		}
+		in.Delim(']')
 	}
-	{
-		const prefix string = ",\"creator\":"
-		out.RawString(prefix)
-		out.String(string(in.Creator))
+	if isTopLevel {
+		in.Consumed()
 	}
-	{
-		const prefix string = ",\"creatorId\":"
-		out.RawString(prefix)
-		out.Uint64(uint64(in.CreatorId))
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels96(out *jwriter.Writer, in DifficultyCounts) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v143, v144 := range in {
+			if v143 > 0 {
+				out.RawByte(',')
+			}
+			(v144).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
 	}
-	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ShortTask) MarshalJSON() ([]byte, error) {
+func (v DifficultyCounts) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels22(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels96(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ShortTask) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels22(w, v)
+func (v DifficultyCounts) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels96(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ShortTask) UnmarshalJSON(data []byte) error {
+func (v *DifficultyCounts) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels22(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels96(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ShortTask) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels22(l, v)
+func (v *DifficultyCounts) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels96(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels23(in *jlexer.Lexer, out *ReturnId) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels97(in *jlexer.Lexer, out *DifficultyCount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2364,8 +9769,10 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels23(in *jlexer.Lexer, out *R
 			continue
 		}
 		switch key {
-		case "id":
-			out.Id = uint64(in.Uint64())
+		case "difficulty":
+			out.Difficulty = int(in.Int())
+		case "count":
+			out.Count = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2376,115 +9783,113 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels23(in *jlexer.Lexer, out *R
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels23(out *jwriter.Writer, in ReturnId) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels97(out *jwriter.Writer, in DifficultyCount) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"difficulty\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.Id))
+		out.Int(int(in.Difficulty))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReturnId) MarshalJSON() ([]byte, error) {
+func (v DifficultyCount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels23(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels97(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReturnId) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels23(w, v)
+func (v DifficultyCount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels97(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReturnId) UnmarshalJSON(data []byte) error {
+func (v *DifficultyCount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels23(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels97(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReturnId) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels23(l, v)
+func (v *DifficultyCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels97(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels24(in *jlexer.Lexer, out *PasswordNew) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels98(in *jlexer.Lexer, out *DiffLines) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
 		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(DiffLines, 0, 1)
+			} else {
+				*out = DiffLines{}
+			}
+		} else {
+			*out = (*out)[:0]
 		}
-		switch key {
-		case "oldPassword":
-			out.Old = string(in.String())
-		case "newPassword":
-			out.New = string(in.String())
-		default:
-			in.SkipRecursive()
+		for !in.IsDelim(']') {
+			var v145 DiffLine
+			(v145).UnmarshalEasyJSON(in)
+			*out = append(*out, v145)
+			in.WantComma()
 		}
-		in.WantComma()
+		in.Delim(']')
 	}
-	in.Delim('}')
 	if isTopLevel {
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels24(out *jwriter.Writer, in PasswordNew) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"oldPassword\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Old))
-	}
-	{
-		const prefix string = ",\"newPassword\":"
-		out.RawString(prefix)
-		out.String(string(in.New))
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels98(out *jwriter.Writer, in DiffLines) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v146, v147 := range in {
+			if v146 > 0 {
+				out.RawByte(',')
+			}
+			(v147).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
 	}
-	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PasswordNew) MarshalJSON() ([]byte, error) {
+func (v DiffLines) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels24(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels98(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PasswordNew) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels24(w, v)
+func (v DiffLines) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels98(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PasswordNew) UnmarshalJSON(data []byte) error {
+func (v *DiffLines) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels24(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels98(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PasswordNew) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels24(l, v)
+func (v *DiffLines) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels98(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels25(in *jlexer.Lexer, out *Pases) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels99(in *jlexer.Lexer, out *DiffLine) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2503,8 +9908,14 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels25(in *jlexer.Lexer, out *P
 			continue
 		}
 		switch key {
-		case "count":
-			out.Count = int(in.Int())
+		case "kind":
+			out.Kind = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "oldLine":
+			out.OldLine = int(in.Int())
+		case "newLine":
+			out.NewLine = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -2515,42 +9926,57 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels25(in *jlexer.Lexer, out *P
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels25(out *jwriter.Writer, in Pases) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels99(out *jwriter.Writer, in DiffLine) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"count\":"
+		const prefix string = ",\"kind\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Count))
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	if in.OldLine != 0 {
+		const prefix string = ",\"oldLine\":"
+		out.RawString(prefix)
+		out.Int(int(in.OldLine))
+	}
+	if in.NewLine != 0 {
+		const prefix string = ",\"newLine\":"
+		out.RawString(prefix)
+		out.Int(int(in.NewLine))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Pases) MarshalJSON() ([]byte, error) {
+func (v DiffLine) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels25(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels99(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Pases) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels25(w, v)
+func (v DiffLine) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels99(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Pases) UnmarshalJSON(data []byte) error {
+func (v *DiffLine) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels25(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels99(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Pases) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels25(l, v)
+func (v *DiffLine) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels99(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels26(in *jlexer.Lexer, out *InputTests) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels100(in *jlexer.Lexer, out *DiffHunks) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -2559,38 +9985,17 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels26(in *jlexer.Lexer, out *I
 		in.Delim('[')
 		if *out == nil {
 			if !in.IsDelim(']') {
-				*out = make(InputTests, 0, 2)
+				*out = make(DiffHunks, 0, 1)
 			} else {
-				*out = InputTests{}
+				*out = DiffHunks{}
 			}
 		} else {
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v28 []string
-			if in.IsNull() {
-				in.Skip()
-				v28 = nil
-			} else {
-				in.Delim('[')
-				if v28 == nil {
-					if !in.IsDelim(']') {
-						v28 = make([]string, 0, 4)
-					} else {
-						v28 = []string{}
-					}
-				} else {
-					v28 = (v28)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v29 string
-					v29 = string(in.String())
-					v28 = append(v28, v29)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-			*out = append(*out, v28)
+			var v148 DiffHunk
+			(v148).UnmarshalEasyJSON(in)
+			*out = append(*out, v148)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -2599,56 +10004,45 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels26(in *jlexer.Lexer, out *I
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels26(out *jwriter.Writer, in InputTests) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels100(out *jwriter.Writer, in DiffHunks) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v30, v31 := range in {
-			if v30 > 0 {
+		for v149, v150 := range in {
+			if v149 > 0 {
 				out.RawByte(',')
 			}
-			if v31 == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-				out.RawString("null")
-			} else {
-				out.RawByte('[')
-				for v32, v33 := range v31 {
-					if v32 > 0 {
-						out.RawByte(',')
-					}
-					out.String(string(v33))
-				}
-				out.RawByte(']')
-			}
+			(v150).MarshalEasyJSON(out)
 		}
 		out.RawByte(']')
 	}
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v InputTests) MarshalJSON() ([]byte, error) {
+func (v DiffHunks) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels26(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels100(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v InputTests) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels26(w, v)
+func (v DiffHunks) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels100(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *InputTests) UnmarshalJSON(data []byte) error {
+func (v *DiffHunks) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels26(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels100(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *InputTests) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels26(l, v)
+func (v *DiffHunks) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels100(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels27(in *jlexer.Lexer, out *IdValue) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels101(in *jlexer.Lexer, out *DiffHunk) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2667,8 +10061,16 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels27(in *jlexer.Lexer, out *I
 			continue
 		}
 		switch key {
-		case "id":
-			out.Id = string(in.String())
+		case "oldStart":
+			out.OldStart = int(in.Int())
+		case "oldLines":
+			out.OldLines = int(in.Int())
+		case "newStart":
+			out.NewStart = int(in.Int())
+		case "newLines":
+			out.NewLines = int(in.Int())
+		case "lines":
+			(out.Lines).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -2679,42 +10081,62 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels27(in *jlexer.Lexer, out *I
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels27(out *jwriter.Writer, in IdValue) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels101(out *jwriter.Writer, in DiffHunk) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"oldStart\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Id))
+		out.Int(int(in.OldStart))
+	}
+	{
+		const prefix string = ",\"oldLines\":"
+		out.RawString(prefix)
+		out.Int(int(in.OldLines))
+	}
+	{
+		const prefix string = ",\"newStart\":"
+		out.RawString(prefix)
+		out.Int(int(in.NewStart))
+	}
+	{
+		const prefix string = ",\"newLines\":"
+		out.RawString(prefix)
+		out.Int(int(in.NewLines))
+	}
+	{
+		const prefix string = ",\"lines\":"
+		out.RawString(prefix)
+		(in.Lines).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v IdValue) MarshalJSON() ([]byte, error) {
+func (v DiffHunk) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels27(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels101(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v IdValue) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels27(w, v)
+func (v DiffHunk) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels101(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *IdValue) UnmarshalJSON(data []byte) error {
+func (v *DiffHunk) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels27(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels101(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *IdValue) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels27(l, v)
+func (v *DiffHunk) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels101(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels28(in *jlexer.Lexer, out *ClearedTask) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels102(in *jlexer.Lexer, out *ClearedTask) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2747,7 +10169,7 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels28(in *jlexer.Lexer, out *C
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels28(out *jwriter.Writer, in ClearedTask) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels102(out *jwriter.Writer, in ClearedTask) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2767,27 +10189,130 @@ func easyjsonD2b7633eEncodeLiokoreduApplicationModels28(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v ClearedTask) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels28(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels102(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ClearedTask) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels28(w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels102(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ClearedTask) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels28(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels102(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ClearedTask) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels28(l, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels102(l, v)
+}
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels103(in *jlexer.Lexer, out *CallbackAudit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "SolutionId":
+			out.SolutionId = uint64(in.Uint64())
+		case "RemoteAddr":
+			out.RemoteAddr = string(in.String())
+		case "Reason":
+			out.Reason = string(in.String())
+		case "Signature":
+			out.Signature = string(in.String())
+		case "Body":
+			out.Body = string(in.String())
+		case "ReceivedDateTime":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ReceivedDateTime).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels103(out *jwriter.Writer, in CallbackAudit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"SolutionId\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.SolutionId))
+	}
+	{
+		const prefix string = ",\"RemoteAddr\":"
+		out.RawString(prefix)
+		out.String(string(in.RemoteAddr))
+	}
+	{
+		const prefix string = ",\"Reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"Signature\":"
+		out.RawString(prefix)
+		out.String(string(in.Signature))
+	}
+	{
+		const prefix string = ",\"Body\":"
+		out.RawString(prefix)
+		out.String(string(in.Body))
+	}
+	{
+		const prefix string = ",\"ReceivedDateTime\":"
+		out.RawString(prefix)
+		out.Raw((in.ReceivedDateTime).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CallbackAudit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels103(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CallbackAudit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels103(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CallbackAudit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels103(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CallbackAudit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels103(l, v)
 }
-func easyjsonD2b7633eDecodeLiokoreduApplicationModels29(in *jlexer.Lexer, out *Avatar) {
+func easyjsonD2b7633eDecodeLiokoreduApplicationModels104(in *jlexer.Lexer, out *Avatar) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2818,7 +10343,7 @@ func easyjsonD2b7633eDecodeLiokoreduApplicationModels29(in *jlexer.Lexer, out *A
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeLiokoreduApplicationModels29(out *jwriter.Writer, in Avatar) {
+func easyjsonD2b7633eEncodeLiokoreduApplicationModels104(out *jwriter.Writer, in Avatar) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2833,23 +10358,23 @@ func easyjsonD2b7633eEncodeLiokoreduApplicationModels29(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v Avatar) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels29(&w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels104(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Avatar) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeLiokoreduApplicationModels29(w, v)
+	easyjsonD2b7633eEncodeLiokoreduApplicationModels104(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Avatar) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels29(&r, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels104(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Avatar) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeLiokoreduApplicationModels29(l, v)
+	easyjsonD2b7633eDecodeLiokoreduApplicationModels104(l, v)
 }
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// PlagiarismPair is a couple of accepted solutions of different users which
+// are suspiciously similar. Score is from 0 to 1.
+type PlagiarismPair struct {
+	Id              uint64    `json:"id"`
+	TaskId          uint64    `json:"taskId"`
+	Language        string    `json:"language"`
+	SolutionA       uint64    `json:"solutionA"`
+	UidA            uint64    `json:"uidA"`
+	UsernameA       string    `json:"usernameA"`
+	SolutionB       uint64    `json:"solutionB"`
+	UidB            uint64    `json:"uidB"`
+	UsernameB       string    `json:"usernameB"`
+	Score           float64   `json:"score"`
+	CheckedDateTime time.Time `json:"checkedDatetime"`
+}
+
+//easyjson:json
+type PlagiarismPairs []PlagiarismPair
+
+// PlagiarismMatch is a part of solution A found in solution B, lines are
+// numbered from 1 and inclusive.
+type PlagiarismMatch struct {
+	FileA  string `json:"fileA"`
+	StartA int    `json:"startA"`
+	EndA   int    `json:"endA"`
+	FileB  string `json:"fileB"`
+	StartB int    `json:"startB"`
+	EndB   int    `json:"endB"`
+}
+
+//easyjson:json
+type PlagiarismMatches []PlagiarismMatch
+
+// PlagiarismView shows both solutions of a pair side by side.
+type PlagiarismView struct {
+	Pair    PlagiarismPair         `json:"pair"`
+	SourceA map[string]interface{} `json:"sourceA"`
+	SourceB map[string]interface{} `json:"sourceB"`
+	Matches PlagiarismMatches      `json:"matches"`
+}
+
+// States of a plagiarism check.
+const (
+	PlagiarismStatusQueued  = "queued"
+	PlagiarismStatusRunning = "running"
+	PlagiarismStatusDone    = "done"
+	PlagiarismStatusFailed  = "failed"
+)
+
+// PlagiarismReport sums up a check of all solutions of a task. Checks run in
+// background, Status tells the state of the latest requested one, while
+// Solutions, Pairs and CheckedDateTime are of the last finished one, if any.
+type PlagiarismReport struct {
+	TaskId            uint64     `json:"taskId"`
+	Status            string     `json:"status"`
+	Solutions         int        `json:"solutions"`
+	Pairs             int        `json:"pairs"`
+	Error             string     `json:"error,omitempty"`
+	RequestedDateTime time.Time  `json:"requestedDatetime"`
+	CheckedDateTime   *time.Time `json:"checkedDatetime,omitempty"`
+}
+
+type PlagiarismPairSQL struct {
+	Id              uint64
+	TaskId          uint64
+	Language        string
+	SolutionA       uint64
+	UidA            uint64
+	UsernameA       string
+	SolutionB       uint64
+	UidB            uint64
+	UsernameB       string
+	Score           float64
+	Matches         string
+	CheckedDateTime time.Time
+}
+
+//easyjson:json
+type PlagiarismPairsSQL []PlagiarismPairSQL
+
+func (pSQL PlagiarismPairSQL) ConvertToJson() PlagiarismPair {
+	return PlagiarismPair{
+		Id:              pSQL.Id,
+		TaskId:          pSQL.TaskId,
+		Language:        pSQL.Language,
+		SolutionA:       pSQL.SolutionA,
+		UidA:            pSQL.UidA,
+		UsernameA:       pSQL.UsernameA,
+		SolutionB:       pSQL.SolutionB,
+		UidB:            pSQL.UidB,
+		UsernameB:       pSQL.UsernameB,
+		Score:           pSQL.Score,
+		CheckedDateTime: pSQL.CheckedDateTime,
+	}
+}
+
+func (psSQL PlagiarismPairsSQL) ConvertToJson() PlagiarismPairs {
+	res := PlagiarismPairs{}
+	for _, elem := range psSQL {
+		res = append(res, elem.ConvertToJson())
+	}
+	return res
+}
+
+// ConvertToView pairs the match regions stored with the solutions.
+func (pSQL PlagiarismPairSQL) ConvertToView(sourceA map[string]interface{}, sourceB map[string]interface{}) (PlagiarismView, error) {
+	view := PlagiarismView{
+		Pair:    pSQL.ConvertToJson(),
+		SourceA: sourceA,
+		SourceB: sourceB,
+		Matches: PlagiarismMatches{},
+	}
+	if err := json.Unmarshal([]byte(pSQL.Matches), &view.Matches); err != nil {
+		return PlagiarismView{}, err
+	}
+
+	return view, nil
+}
@@ -0,0 +1,113 @@
+package models
+
+import "time"
+
+// ReviewThread is a discussion of lines of a solution file, lines are
+// numbered from 1 and inclusive. The first comment opens the thread.
+type ReviewThread struct {
+	Id              uint64         `json:"id"`
+	SolutionId      uint64         `json:"solutionId"`
+	FileName        string         `json:"fileName"`
+	LineStart       int            `json:"lineStart"`
+	LineEnd         int            `json:"lineEnd"`
+	Resolved        bool           `json:"resolved"`
+	CreatedDateTime time.Time      `json:"createdDatetime"`
+	Comments        ReviewComments `json:"comments"`
+}
+
+//easyjson:json
+type ReviewThreads []ReviewThread
+
+type ReviewComment struct {
+	Id              uint64    `json:"id"`
+	Uid             uint64    `json:"uid"`
+	Username        string    `json:"username"`
+	Text            string    `json:"text"`
+	CreatedDateTime time.Time `json:"createdDatetime"`
+}
+
+//easyjson:json
+type ReviewComments []ReviewComment
+
+// ReviewThreadNew opens a thread on lines of a file.
+type ReviewThreadNew struct {
+	FileName  string `json:"fileName"`
+	LineStart int    `json:"lineStart"`
+	LineEnd   int    `json:"lineEnd"`
+	Text      string `json:"text"`
+}
+
+type ReviewReply struct {
+	Text string `json:"text"`
+}
+
+type ReviewResolve struct {
+	Resolved bool `json:"resolved"`
+}
+
+type ReviewThreadSQL struct {
+	Id              uint64
+	SolutionId      uint64
+	FileName        string
+	LineStart       int
+	LineEnd         int
+	Uid             uint64
+	Resolved        bool
+	CreatedDateTime time.Time
+}
+
+//easyjson:json
+type ReviewThreadsSQL []ReviewThreadSQL
+
+type ReviewCommentSQL struct {
+	Id              uint64
+	ThreadId        uint64
+	Uid             uint64
+	Text            string
+	CreatedDateTime time.Time
+	// filled by queries joining users only
+	Username string
+}
+
+//easyjson:json
+type ReviewCommentsSQL []ReviewCommentSQL
+
+func (cSQL ReviewCommentSQL) ConvertToComment() ReviewComment {
+	return ReviewComment{
+		Id:              cSQL.Id,
+		Uid:             cSQL.Uid,
+		Username:        cSQL.Username,
+		Text:            cSQL.Text,
+		CreatedDateTime: cSQL.CreatedDateTime,
+	}
+}
+
+// ConvertToJson puts comments into their threads, comments are expected in
+// the order they were written.
+func (thsSQL ReviewThreadsSQL) ConvertToJson(comments ReviewCommentsSQL) ReviewThreads {
+	res := ReviewThreads{}
+	index := map[uint64]int{}
+	for _, th := range thsSQL {
+		index[th.Id] = len(res)
+		res = append(res, th.ConvertToThread())
+	}
+	for _, c := range comments {
+		if i, ok := index[c.ThreadId]; ok {
+			res[i].Comments = append(res[i].Comments, c.ConvertToComment())
+		}
+	}
+	return res
+}
+
+func (thSQL ReviewThreadSQL) ConvertToThread() ReviewThread {
+	return ReviewThread{
+		Id:              thSQL.Id,
+		SolutionId:      thSQL.SolutionId,
+		FileName:        thSQL.FileName,
+		LineStart:       thSQL.LineStart,
+		LineEnd:         thSQL.LineEnd,
+		Resolved:        thSQL.Resolved,
+		CreatedDateTime: thSQL.CreatedDateTime,
+		Comments:        ReviewComments{},
+	}
+}
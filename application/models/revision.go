@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// TaskRevision is a saved state of a task, a new one is made on every
+// change of the task and is never changed itself.
+type TaskRevision struct {
+	Revision int       `json:"revision"`
+	Title    string    `json:"name"`
+	AuthorId uint64    `json:"authorId"`
+	Author   string    `json:"author"`
+	Date     time.Time `json:"date"`
+}
+
+//easyjson:json
+type TaskRevisions []TaskRevision
+
+type TaskRevisionSQL struct {
+	Revision int
+	Title    string
+	AuthorId uint64
+	Author   string
+	Date     time.Time
+}
+
+//easyjson:json
+type TaskRevisionsSQL []TaskRevisionSQL
+
+func (rsql TaskRevisionsSQL) ConvertToJson() TaskRevisions {
+	res := TaskRevisions{}
+	for _, r := range rsql {
+		res = append(res, TaskRevision{
+			Revision: r.Revision,
+			Title:    r.Title,
+			AuthorId: r.AuthorId,
+			Author:   r.Author,
+			Date:     r.Date,
+		})
+	}
+	return res
+}
+
+// TaskRevisionDiff shows what changed from one revision of a task to
+// another, every part of the task is diffed as a file.
+type TaskRevisionDiff struct {
+	From  int       `json:"from"`
+	To    int       `json:"to"`
+	Files FileDiffs `json:"files"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TextSearchQuery turns user input into a tsquery matching tasks with all
+// of the words, the last one may be typed partially, so each word matches
+// as a prefix. It is empty when the input has no words.
+func TextSearchQuery(s string) string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range words {
+		words[i] = w + ":*"
+	}
+	return strings.Join(words, " & ")
+}
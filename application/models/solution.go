@@ -6,6 +6,7 @@ import (
 )
 
 type Solution struct {
+	Language   string                 `json:"language"`
 	SourceCode map[string]interface{} `json:"sourceCode"`
 }
 
@@ -15,21 +16,82 @@ type SolutionFile struct {
 
 type SolutionSend struct {
 	Id         uint64                 `json:"id"`
+	Nonce      string                 `json:"nonce"`
 	SourceCode map[string]interface{} `json:"sourceCode"`
 	Tests      InputTests             `json:"tests"`
+	Checker    TaskChecker            `json:"checker"`
+	Limits     TaskLimits             `json:"limits"`
+	Language   Language               `json:"language"`
 }
 
 //easyjson:json
 type InputTests [][]string
 
+// Solution statuses reported while a solution is being judged.
+const (
+	SolutionStatusQueued    = "queued"
+	SolutionStatusCompiling = "compiling"
+	SolutionStatusRunning   = "running"
+	SolutionStatusChecked   = "checked"
+)
+
 type SolutionUpdate struct {
-	Code            int       `json:"checkResult"`
+	Status          string    `json:"status"`
+	CurrentTest     int       `json:"currentTest"`
+	Code            Verdict   `json:"checkResult"`
 	CheckMessage    string    `json:"checkMessage"`
 	CheckedDateTime time.Time `json:"checkedDatetime"`
 	CheckTime       float32   `json:"checkTime"`
 	CompileTime     float32   `json:"buildTime"`
 	Passed          int       `json:"testsPassed"`
 	TestsTotal      int       `json:"testsTotal"`
+	Tests           TestRuns  `json:"tests"`
+	// counted by the backend from the subtasks of the task
+	Score int `json:"-"`
+	// revision of the task the solution was judged on
+	TaskRevision int `json:"-"`
+}
+
+// TestRun is a result of running a solution on a single test as reported
+// by the checker. Memory is the peak usage in kilobytes.
+type TestRun struct {
+	Passed  bool    `json:"passed"`
+	Verdict Verdict `json:"checkResult"`
+	Stdout  string  `json:"stdout"`
+	Stderr  string  `json:"stderr"`
+	RunTime float32 `json:"time"`
+	Memory  int64   `json:"memory"`
+}
+
+//easyjson:json
+type TestRuns []TestRun
+
+type SolutionTestSQL struct {
+	Id         uint64
+	SolutionId uint64
+	TestNum    int
+	Passed     bool
+	Verdict    Verdict
+	Stdout     string
+	Stderr     string
+	RunTime    float32
+	Memory     int64
+}
+
+//easyjson:json
+type SolutionTestsSQL []SolutionTestSQL
+
+// SolutionEvent is pushed to subscribers on every status transition.
+type SolutionEvent struct {
+	Id           uint64  `json:"id"`
+	TaskId       uint64  `json:"taskId"`
+	Status       string  `json:"status"`
+	CurrentTest  int     `json:"currentTest,omitempty"`
+	CheckResult  Verdict `json:"checkResult"`
+	CheckMessage string  `json:"checkMessage"`
+	TestsPassed  int     `json:"testsPassed"`
+	TestsTotal   int     `json:"testsTotal"`
+	Score        int     `json:"score"`
 }
 
 type SolutionSQL struct {
@@ -38,49 +100,97 @@ type SolutionSQL struct {
 	CheckedDateTime  time.Time
 	SourceCode       string
 	TaskId           uint64
-	CheckResult      int
+	CheckResult      Verdict
 	CheckTime        float32
 	CompileTime      float32
 	CheckMessage     string
 	TestsPassed      int
 	TestsTotal       int
+	Score            int
+	TaskRevision     int
 	Uid              uint64
+	Language         string
+	// tests changed while the solution was being judged
+	Rejudge bool
+	// filled by queries joining users only
+	Username string
+}
+
+// SolutionFilter selects a page of solutions history, zero fields don't
+// filter. Pages are taken by id: newer than Cursor when Ascending, older
+// otherwise.
+type SolutionFilter struct {
+	TaskId     uint64
+	Uid        uint64
+	AllUsers   bool
+	UseVerdict bool
+	Verdict    Verdict
+	Language   string
+	From       time.Time
+	To         time.Time
+	Cursor     uint64
+	Ascending  bool
+	Count      int
 }
 
 type SolutionOne struct {
 	Id               uint64    `json:"id"`
+	TaskId           uint64    `json:"taskId"`
+	Uid              uint64    `json:"uid"`
+	Username         string    `json:"username,omitempty"`
+	Language         string    `json:"language"`
 	SourceCode       string    `json:"sourceCode"`
 	ReceivedDateTime time.Time `json:"receivedDatetime"`
 	CheckedDateTime  time.Time `json:"checkedDatetime"`
-	CheckResult      int       `json:"checkResult"`
+	CheckResult      Verdict   `json:"checkResult"`
+	Verdict          string    `json:"verdict"`
 	CheckTime        float32   `json:"checkTime"`
 	CheckMessage     string    `json:"checkMessage"`
 	CompileTime      float32   `json:"compileTime"`
 	TestsPassed      int       `json:"testsPassed"`
 	TestsTotal       int       `json:"testsTotal"`
+	Score            int       `json:"score"`
+	TaskRevision     int       `json:"taskRevision"`
 }
 
 type SolutionFull struct {
 	Id               uint64                 `json:"id"`
+	Language         string                 `json:"language"`
 	SourceCode       map[string]interface{} `json:"sourceCode"`
 	ReceivedDateTime time.Time              `json:"receivedDatetime"`
 	CheckedDateTime  time.Time              `json:"checkedDatetime"`
-	CheckResult      int                    `json:"checkResult"`
+	CheckResult      Verdict                `json:"checkResult"`
+	Verdict          string                 `json:"verdict"`
 	CheckMessage     string                 `json:"checkMessage"`
 	CheckTime        float32                `json:"checkTime"`
 	CompileTime      float32                `json:"compileTime"`
 	Tests            TestResults            `json:"tests"`
 	TestsPassed      int                    `json:"testsPassed"`
 	TestsTotal       int                    `json:"testsTotal"`
+	Score            int                    `json:"score"`
+	MaxScore         int                    `json:"maxScore"`
+	TaskRevision     int                    `json:"taskRevision"`
+	Subtasks         SubtaskResults         `json:"subtasks"`
+	Reviews          ReviewThreads          `json:"reviews"`
 }
 
 //easyjson:json
 type TestResults []TestResult
 
+// TestResult shows how the solution did on a test, contents of hidden tests
+// are left out.
 type TestResult struct {
-	Stdin  string `json:"stdin"`
-	Stdout string `json:"stdout"`
-	Passed bool   `json:"passed"`
+	Sample         bool    `json:"sample"`
+	Hidden         bool    `json:"hidden"`
+	Note           string  `json:"note,omitempty"`
+	Stdin          string  `json:"stdin"`
+	ExpectedStdout string  `json:"expectedStdout"`
+	Stdout         string  `json:"stdout"`
+	Stderr         string  `json:"stderr"`
+	Passed         bool    `json:"passed"`
+	Verdict        Verdict `json:"checkResult"`
+	RunTime        float32 `json:"time"`
+	Memory         int64   `json:"memory"`
 }
 
 //easyjson:json
@@ -93,6 +203,11 @@ type ReturnId struct {
 	Id uint64 `json:"id"`
 }
 
+type SolutionPosted struct {
+	Id          uint64  `json:"id"`
+	CheckResult Verdict `json:"checkResult"`
+}
+
 func (slnsSQL SolutionsSQL) ConvertToJson() Solutions {
 	res := Solutions{}
 	for _, elem := range slnsSQL {
@@ -105,11 +220,16 @@ func (slnSQL SolutionSQL) ConvertToShort() SolutionOne {
 	newElem := SolutionOne{}
 
 	newElem.Id = slnSQL.Id
+	newElem.TaskId = slnSQL.TaskId
+	newElem.Uid = slnSQL.Uid
+	newElem.Username = slnSQL.Username
+	newElem.Language = slnSQL.Language
 
 	newElem.ReceivedDateTime = slnSQL.ReceivedDateTime
 	newElem.CheckedDateTime = slnSQL.CheckedDateTime
 
 	newElem.CheckResult = slnSQL.CheckResult
+	newElem.Verdict = slnSQL.CheckResult.String()
 	newElem.CheckMessage = slnSQL.CheckMessage
 
 	newElem.CheckTime = slnSQL.CheckTime
@@ -117,18 +237,24 @@ func (slnSQL SolutionSQL) ConvertToShort() SolutionOne {
 
 	newElem.TestsPassed = slnSQL.TestsPassed
 	newElem.TestsTotal = slnSQL.TestsTotal
+	newElem.Score = slnSQL.Score
+	newElem.TaskRevision = slnSQL.TaskRevision
 	return newElem
 }
 
-func (slnSQL SolutionSQL) ConvertToFull(tsk *Task) SolutionFull {
+// ConvertToFull adds test results to the solution, outputs of hidden tests
+// are shown only with showHidden, as they could print the input.
+func (slnSQL SolutionSQL) ConvertToFull(tsk *Task, runs SolutionTestsSQL, showHidden bool) SolutionFull {
 	newElem := SolutionFull{}
 
 	newElem.Id = slnSQL.Id
+	newElem.Language = slnSQL.Language
 
 	newElem.ReceivedDateTime = slnSQL.ReceivedDateTime
 	newElem.CheckedDateTime = slnSQL.CheckedDateTime
 
 	newElem.CheckResult = slnSQL.CheckResult
+	newElem.Verdict = slnSQL.CheckResult.String()
 	newElem.CheckMessage = slnSQL.CheckMessage
 
 	newElem.CheckTime = slnSQL.CheckTime
@@ -136,25 +262,39 @@ func (slnSQL SolutionSQL) ConvertToFull(tsk *Task) SolutionFull {
 
 	newElem.TestsPassed = slnSQL.TestsPassed
 	newElem.TestsTotal = slnSQL.TestsTotal
+	newElem.Score = slnSQL.Score
+	newElem.MaxScore = tsk.MaxScore
+	newElem.TaskRevision = slnSQL.TaskRevision
 
-	i := 0
 	tests := TestResults{}
-	for ; i < slnSQL.TestsPassed; i++ {
+	passed := TestRuns{}
+	for _, run := range runs {
 		test := TestResult{}
-		test.Stdin = tsk.Tests[i][0]
-		test.Stdout = tsk.Tests[i][1]
-		test.Passed = true
-		tests = append(tests, test)
-	}
-
-	if slnSQL.TestsPassed < slnSQL.TestsTotal {
-		test := TestResult{}
-
-		test.Stdin = tsk.Tests[i][0]
-		test.Stdout = tsk.Tests[i][1]
-		test.Passed = false
+		// tests of an unknown revision are not shown, the output is left
+		// to those who see hidden tests as the test could have been one
+		known := run.TestNum < len(tsk.Tests)
+		if known {
+			tt := tsk.Tests[run.TestNum]
+			test.Sample = tt.Sample
+			test.Hidden = tt.Hidden
+			test.Note = tt.Note
+			test.Stdin = tt.Stdin
+			test.ExpectedStdout = tt.Stdout
+		}
+		test.Stdout = run.Stdout
+		test.Stderr = run.Stderr
+		if (test.Hidden || !known) && !showHidden {
+			test.Stdin, test.ExpectedStdout, test.Stdout, test.Stderr = "", "", "", ""
+		}
+		test.Passed = run.Passed
+		test.Verdict = run.Verdict
+		test.RunTime = run.RunTime
+		test.Memory = run.Memory
 		tests = append(tests, test)
+		passed = append(passed, TestRun{Passed: run.Passed})
 	}
+	// the score is stored, subtasks only show where it comes from
+	_, newElem.Subtasks = tsk.Subtasks.Score(passed, slnSQL.CheckResult == VerdictAccepted)
 
 	newElem.Tests = tests
 
@@ -164,3 +304,88 @@ func (slnSQL SolutionSQL) ConvertToFull(tsk *Task) SolutionFull {
 
 	return newElem
 }
+
+// Statuses of files in a diff of solutions.
+const (
+	FileAdded    = "added"
+	FileRemoved  = "removed"
+	FileModified = "modified"
+)
+
+// Kinds of lines of a diff hunk.
+const (
+	DiffLineContext = "context"
+	DiffLineAdded   = "added"
+	DiffLineRemoved = "removed"
+)
+
+// SolutionDiff shows what changed from one solution to another, unchanged
+// files are left out.
+type SolutionDiff struct {
+	From  uint64    `json:"from"`
+	To    uint64    `json:"to"`
+	Files FileDiffs `json:"files"`
+}
+
+type FileDiff struct {
+	Name    string    `json:"name"`
+	Status  string    `json:"status"`
+	Unified string    `json:"unified"`
+	Hunks   DiffHunks `json:"hunks"`
+}
+
+//easyjson:json
+type FileDiffs []FileDiff
+
+// DiffHunk is a group of changed lines with some unchanged ones around.
+// Line numbers start from 1, OldLine of added and NewLine of removed lines
+// are zero.
+type DiffHunk struct {
+	OldStart int       `json:"oldStart"`
+	OldLines int       `json:"oldLines"`
+	NewStart int       `json:"newStart"`
+	NewLines int       `json:"newLines"`
+	Lines    DiffLines `json:"lines"`
+}
+
+//easyjson:json
+type DiffHunks []DiffHunk
+
+type DiffLine struct {
+	Kind    string `json:"kind"`
+	Text    string `json:"text"`
+	OldLine int    `json:"oldLine,omitempty"`
+	NewLine int    `json:"newLine,omitempty"`
+}
+
+//easyjson:json
+type DiffLines []DiffLine
+
+// RunRequest is a program the user wants to try on own input, it is not
+// stored as a solution.
+type RunRequest struct {
+	Language   string                 `json:"language"`
+	SourceCode map[string]interface{} `json:"sourceCode"`
+	Stdin      string                 `json:"stdin"`
+}
+
+// RunSend is a one-off run handed over to the judge.
+type RunSend struct {
+	SourceCode map[string]interface{} `json:"sourceCode"`
+	Stdin      string                 `json:"stdin"`
+	Limits     TaskLimits             `json:"limits"`
+	Language   Language               `json:"language"`
+}
+
+// RunResult is an output of a one-off run. Verdict is accepted when the
+// program finished normally, the output itself is not checked.
+type RunResult struct {
+	Verdict      Verdict `json:"checkResult"`
+	VerdictName  string  `json:"verdict"`
+	CheckMessage string  `json:"checkMessage"`
+	Stdout       string  `json:"stdout"`
+	Stderr       string  `json:"stderr"`
+	RunTime      float32 `json:"time"`
+	CompileTime  float32 `json:"buildTime"`
+	Memory       int64   `json:"memory"`
+}
@@ -0,0 +1,99 @@
+package models
+
+import "liokoredu/pkg/constants"
+
+// TaskSubtask is a group of tests worth Points. The points are given only
+// when every test of the subtask passes and every subtask it depends on is
+// scored as well. Tests and Depends are numbers starting from 1, a subtask
+// depends only on earlier ones.
+type TaskSubtask struct {
+	Name    string `json:"name"`
+	Points  int    `json:"points"`
+	Tests   []int  `json:"tests"`
+	Depends []int  `json:"depends,omitempty"`
+}
+
+//easyjson:json
+type TaskSubtasks []TaskSubtask
+
+// SubtaskResult is how a solution did on a subtask.
+type SubtaskResult struct {
+	Num    int    `json:"num"`
+	Name   string `json:"name"`
+	Points int    `json:"points"`
+	Score  int    `json:"score"`
+	Passed bool   `json:"passed"`
+}
+
+//easyjson:json
+type SubtaskResults []SubtaskResult
+
+// MaxScore is the score of a solution passing every test. Tasks without
+// subtasks are worth constants.DefaultMaxScore.
+func (ts TaskSubtasks) MaxScore() int {
+	if len(ts) == 0 {
+		return constants.DefaultMaxScore
+	}
+	score := 0
+	for _, st := range ts {
+		score += st.Points
+	}
+	return score
+}
+
+func (ts TaskSubtasks) Validate() bool {
+	for i, st := range ts {
+		if st.Points <= 0 || len(st.Tests) == 0 {
+			return false
+		}
+		for _, num := range st.Tests {
+			if num < 1 {
+				return false
+			}
+		}
+		for _, num := range st.Depends {
+			if num < 1 || num > i {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Score counts points of the solution with the given runs of tests. Tests
+// without a run are failed, so are all tests of a solution which was not
+// compiled. A task without subtasks is all or nothing, so only the verdict
+// matters.
+func (ts TaskSubtasks) Score(runs TestRuns, accepted bool) (int, SubtaskResults) {
+	if len(ts) == 0 {
+		if accepted {
+			return ts.MaxScore(), SubtaskResults{}
+		}
+		return 0, SubtaskResults{}
+	}
+
+	passed := func(num int) bool {
+		return num >= 1 && num <= len(runs) && runs[num-1].Passed
+	}
+
+	score := 0
+	results := SubtaskResults{}
+	for i, st := range ts {
+		res := SubtaskResult{Num: i + 1, Name: st.Name, Points: st.Points, Passed: true}
+		for _, num := range st.Tests {
+			res.Passed = res.Passed && passed(num)
+		}
+
+		scored := res.Passed
+		for _, num := range st.Depends {
+			scored = scored && num <= len(results) && results[num-1].Score > 0
+		}
+		if scored {
+			res.Score = st.Points
+			score += st.Points
+		}
+		results = append(results, res)
+	}
+
+	return score, results
+}
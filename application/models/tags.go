@@ -0,0 +1,75 @@
+package models
+
+import (
+	"liokoredu/pkg/constants"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// TaskFilter selects a page of the task catalog, zero fields don't filter.
+// A task must have all of Tags and none of ExcludeTags.
+type TaskFilter struct {
+	Find          string
+	Uid           uint64
+	UseSolved     bool
+	Solved        bool
+	Mine          bool
+	Tags          []string
+	ExcludeTags   []string
+	MinDifficulty int
+	MaxDifficulty int
+	Page          int
+	Count         int
+}
+
+// TaskFacets are numbers of tasks per tag and per difficulty among the
+// filtered ones. Difficulties are counted regardless of the difficulty
+// range, so other levels can be picked.
+type TaskFacets struct {
+	Tags         TagCounts        `json:"tags"`
+	Difficulties DifficultyCounts `json:"difficulties"`
+}
+
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+//easyjson:json
+type TagCounts []TagCount
+
+type DifficultyCount struct {
+	Difficulty int `json:"difficulty"`
+	Count      int `json:"count"`
+}
+
+//easyjson:json
+type DifficultyCounts []DifficultyCount
+
+// NormalizeTags lowercases tags, drops empty ones and duplicates. It returns
+// false when a tag is too long or has characters other than letters,
+// digits, spaces, '-' and '+'.
+func NormalizeTags(tags []string) ([]string, bool) {
+	seen := map[string]bool{}
+	res := []string{}
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), " ")
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > constants.TagLength {
+			return nil, false
+		}
+		for _, r := range tag {
+			if !(r == ' ' || r == '-' || r == '+' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' ||
+				r >= 'а' && r <= 'я' || r == 'ё') {
+				return nil, false
+			}
+		}
+		seen[tag] = true
+		res = append(res, tag)
+	}
+	sort.Strings(res)
+	return res, true
+}
@@ -8,27 +8,43 @@ import (
 )
 
 type Task struct {
-	Id          uint64     `json:"id"`
-	Title       string     `json:"name"`
-	Description string     `json:"description"`
-	Input       string     `json:"stdinDescription"`
-	Output      string     `json:"stdoutDescription"`
-	Hints       string     `json:"hints"`
-	TestsAmount int        `json:"testsAmount"`
-	Tests       InputTests `json:"tests"`
-	Author      string     `json:"author"`
-	AuthorId    string     `json:"authorId"`
-	IsCleared   bool       `json:"isCleared"`
+	Id          uint64       `json:"id"`
+	Title       string       `json:"name"`
+	Description string       `json:"description"`
+	Input       string       `json:"stdinDescription"`
+	Output      string       `json:"stdoutDescription"`
+	Hints       string       `json:"hints"`
+	TestsAmount int          `json:"testsAmount"`
+	Tests       TaskTests    `json:"tests"`
+	TestsStored bool         `json:"testsStored"`
+	Subtasks    TaskSubtasks `json:"subtasks"`
+	MaxScore    int          `json:"maxScore"`
+	Score       int          `json:"score"`
+	Tags        []string     `json:"tags"`
+	Difficulty  int          `json:"difficulty"`
+	Checker     TaskChecker  `json:"checker"`
+	Limits      TaskLimits   `json:"limits"`
+	Languages   []string     `json:"languages"`
+	Revision    int          `json:"revision"`
+	Author      string       `json:"author"`
+	AuthorId    string       `json:"authorId"`
+	IsCleared   bool         `json:"isCleared"`
 }
 
 type ShortTask struct {
-	Id          uint64 `json:"id"`
-	Title       string `json:"name"`
-	Description string `json:"description"`
-	TestAmount  int    `json:"testsAmount"`
-	IsCleared   bool   `json:"isCleared"`
-	Creator     string `json:"creator"`
-	CreatorId   uint64 `json:"creatorId"`
+	Id          uint64   `json:"id"`
+	Title       string   `json:"name"`
+	Description string   `json:"description"`
+	TestAmount  int      `json:"testsAmount"`
+	MaxScore    int      `json:"maxScore"`
+	Tags        []string `json:"tags"`
+	Difficulty  int      `json:"difficulty"`
+	Score       int      `json:"score"`
+	IsCleared   bool     `json:"isCleared"`
+	// a highlighted part of the description matching the search
+	Headline  string `json:"headline,omitempty"`
+	Creator   string `json:"creator"`
+	CreatorId uint64 `json:"creatorId"`
 }
 
 type Pases struct {
@@ -36,23 +52,30 @@ type Pases struct {
 }
 
 type TasksWithNum struct {
-	Tsks ShortTasks `json:"tasks"`
-	Num  int        `json:"num"`
+	Tsks   ShortTasks  `json:"tasks"`
+	Num    int         `json:"num"`
+	Facets *TaskFacets `json:"facets,omitempty"`
 }
 
 //easyjson:json
 type ShortTasks []ShortTask
 
 type TaskNew struct {
-	Title       string     `json:"name"`
-	Description string     `json:"description"`
-	Input       string     `json:"stdinDescription"`
-	Output      string     `json:"stdoutDescription"`
-	Hints       string     `json:"hints"`
-	Tests       InputTests `json:"tests"`
-	Creator     uint64     `json:"creator"`
-	IsPrivate   bool       `json:"is_private"`
-	Code        string     `json:"code"`
+	Title       string       `json:"name"`
+	Description string       `json:"description"`
+	Input       string       `json:"stdinDescription"`
+	Output      string       `json:"stdoutDescription"`
+	Hints       string       `json:"hints"`
+	Tests       TaskTests    `json:"tests"`
+	Subtasks    TaskSubtasks `json:"subtasks"`
+	Tags        []string     `json:"tags"`
+	Difficulty  int          `json:"difficulty"`
+	Checker     TaskChecker  `json:"checker"`
+	Limits      TaskLimits   `json:"limits"`
+	Languages   []string     `json:"languages"`
+	Creator     uint64       `json:"creator"`
+	IsPrivate   bool         `json:"is_private"`
+	Code        string       `json:"code"`
 }
 
 //easyjson:json
@@ -67,10 +90,21 @@ type TaskSQL struct {
 	Output      string         `sql:"output"`
 	TestAmount  int            `sql:"test_amount"`
 	Tests       string         `sql:"tests"`
-	Creator     uint64         `sql:"creator"`
-	IsPrivate   bool           `sql:"is_private"`
-	Code        sql.NullString `sql:"code"`
-	Date        time.Time      `sql:"date"`
+	TestsKey    string         `sql:"tests_key"`
+	Subtasks    string         `sql:"subtasks"`
+	MaxScore    int            `sql:"max_score"`
+	Difficulty  int            `sql:"difficulty"`
+	Revision    int            `sql:"revision"`
+	Checker     string         `sql:"checker"`
+	TimeLimit   int            `sql:"time_limit"`
+	MemoryLimit int            `sql:"memory_limit"`
+	OutputLimit int            `sql:"output_limit"`
+	// empty when every language is allowed
+	AllowedLanguages []string       `sql:"allowed_languages"`
+	Creator          uint64         `sql:"creator"`
+	IsPrivate        bool           `sql:"is_private"`
+	Code             sql.NullString `sql:"code"`
+	Date             time.Time      `sql:"date"`
 }
 
 //easyjson:json
@@ -94,11 +128,34 @@ func (tsql TaskSQL) ConvertToTask(isCreator bool, isCleared bool) *Task {
 	if err != nil {
 		log.Println("error converting tests: ", err)
 	}
+	t.Tests = t.Tests.WithDefaults()
+	t.TestsStored = tsql.TestsKey != ""
+	t.MaxScore = tsql.MaxScore
+	t.Difficulty = tsql.Difficulty
+	t.Revision = tsql.Revision
+	t.Subtasks = TaskSubtasks{}
+	err = json.Unmarshal([]byte(tsql.Subtasks), &t.Subtasks)
+	if err != nil {
+		log.Println("error converting subtasks: ", err)
+	}
+
+	err = json.Unmarshal([]byte(tsql.Checker), &t.Checker)
+	if err != nil {
+		log.Println("error converting checker: ", err)
+	}
+
+	t.Limits = TaskLimits{
+		Time:   tsql.TimeLimit,
+		Memory: tsql.MemoryLimit,
+		Output: tsql.OutputLimit,
+	}
+
+	t.Languages = tsql.AllowedLanguages
 
 	if !isCreator {
-		if len(t.Tests) >= 2 {
-			t.Tests = t.Tests[:2]
-		}
+		t.Tests = t.Tests.Public()
+		// custom checker is a part of the task solution
+		t.Checker.SourceCode = nil
 	}
 
 	t.IsCleared = isCleared
@@ -115,13 +172,43 @@ func (tn TaskNew) ConvertNewTaskToTaskSQL() *TaskSQL {
 	t.Output = tn.Output
 	t.TestAmount = len(tn.Tests)
 
-	bts, err := json.Marshal(tn.Tests)
+	bts, err := json.Marshal(tn.Tests.WithDefaults())
 	if err != nil {
 		log.Println("error converting tests: ", err)
 	}
 
 	str := string(bts[:])
 	t.Tests = str
+
+	if tn.Subtasks == nil {
+		tn.Subtasks = TaskSubtasks{}
+	}
+	bts, err = json.Marshal(tn.Subtasks)
+	if err != nil {
+		log.Println("error converting subtasks: ", err)
+	}
+	t.Subtasks = string(bts)
+	t.MaxScore = tn.Subtasks.MaxScore()
+	t.Difficulty = tn.Difficulty
+
+	if tn.Checker.Mode == "" {
+		tn.Checker.Mode = CheckerModeExact
+	}
+	bts, err = json.Marshal(tn.Checker)
+	if err != nil {
+		log.Println("error converting checker: ", err)
+	}
+	t.Checker = string(bts)
+
+	limits := tn.Limits.WithDefaults()
+	t.TimeLimit = limits.Time
+	t.MemoryLimit = limits.Memory
+	t.OutputLimit = limits.Output
+
+	t.AllowedLanguages = tn.Languages
+	if t.AllowedLanguages == nil {
+		t.AllowedLanguages = []string{}
+	}
 	t.Creator = tn.Creator
 	t.IsPrivate = tn.IsPrivate
 	t.Code = NewNullString(tn.Code)
@@ -150,7 +237,13 @@ func (tn TaskNew) Validate() bool {
 	if len(tn.Output) == 0 {
 		return false
 	}
-	if len(tn.Tests) < 2 {
+	if len(tn.Tests) < 1 || !tn.Tests.Validate() {
+		return false
+	}
+	if tn.Checker.Mode != "" && !tn.Checker.Validate() {
+		return false
+	}
+	if !tn.Limits.Validate() {
 		return false
 	}
 
@@ -0,0 +1,184 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// TaskTest is a test of a task. Sample tests are shown in the statement,
+// contents of hidden tests are never shown to solvers, not even in results
+// of their solutions.
+//
+// A test is written as ["stdin", "stdout", {"sample": true, "note": "..."}],
+// so clients reading the first two items keep working. Tests without the
+// flags object come from older tasks.
+//
+//easyjson:skip
+type TaskTest struct {
+	Stdin  string
+	Stdout string
+	Sample bool
+	Hidden bool
+	Note   string
+
+	legacy bool
+}
+
+type taskTestFlags struct {
+	Sample bool   `json:"sample,omitempty"`
+	Hidden bool   `json:"hidden,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+type taskTestObject struct {
+	Stdin  string `json:"stdin"`
+	Stdout string `json:"stdout"`
+	taskTestFlags
+}
+
+func (tt TaskTest) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]interface{}{tt.Stdin, tt.Stdout, taskTestFlags{
+		Sample: tt.Sample,
+		Hidden: tt.Hidden,
+		Note:   tt.Note,
+	}})
+}
+
+// UnmarshalJSON reads a test written either as an array or as an object
+// with stdin, stdout and the flags.
+func (tt *TaskTest) UnmarshalJSON(data []byte) error {
+	*tt = TaskTest{}
+
+	if len(data) > 0 && data[0] == '{' {
+		obj := taskTestObject{}
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return err
+		}
+		tt.Stdin, tt.Stdout = obj.Stdin, obj.Stdout
+		tt.Sample, tt.Hidden, tt.Note = obj.Sample, obj.Hidden, obj.Note
+		return nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) < 2 || len(items) > 3 {
+		return errors.New("test must have input, output and optional flags")
+	}
+	if err := json.Unmarshal(items[0], &tt.Stdin); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(items[1], &tt.Stdout); err != nil {
+		return err
+	}
+	if len(items) == 2 {
+		tt.legacy = true
+		return nil
+	}
+
+	flags := taskTestFlags{}
+	if err := json.Unmarshal(items[2], &flags); err != nil {
+		return err
+	}
+	tt.Sample, tt.Hidden, tt.Note = flags.Sample, flags.Hidden, flags.Note
+
+	return nil
+}
+
+//easyjson:json
+type TaskTests []TaskTest
+
+// WithDefaults makes the first two tests samples if none of the tests has
+// flags, that is how tasks were shown before the flags.
+func (ts TaskTests) WithDefaults() TaskTests {
+	res := append(TaskTests{}, ts...)
+	for _, t := range res {
+		if !t.legacy {
+			return res
+		}
+	}
+
+	for i := range res {
+		res[i].Sample = i < 2
+		res[i].legacy = false
+	}
+	return res
+}
+
+// Public leaves the tests shown in the statement.
+func (ts TaskTests) Public() TaskTests {
+	res := TaskTests{}
+	for _, t := range ts {
+		if t.Sample && !t.Hidden {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// Input is the tests as the judge takes them.
+func (ts TaskTests) Input() InputTests {
+	res := InputTests{}
+	for _, t := range ts {
+		res = append(res, []string{t.Stdin, t.Stdout})
+	}
+	return res
+}
+
+// Validate checks that no test is a sample and hidden at once.
+func (ts TaskTests) Validate() bool {
+	for _, t := range ts {
+		if t.Sample && t.Hidden {
+			return false
+		}
+	}
+	return true
+}
+
+// TaskTestSQL is a test kept in blob storage, only its metadata is in the
+// database.
+type TaskTestSQL struct {
+	Id         uint64
+	TaskId     uint64
+	TestNum    int
+	InputKey   string
+	OutputKey  string
+	InputSize  int
+	OutputSize int
+	Sample     bool
+	Hidden     bool
+	Note       string
+}
+
+//easyjson:json
+type TaskTestsSQL []TaskTestSQL
+
+// TaskTestInfo describes a stored test without its contents, sizes are in
+// bytes.
+type TaskTestInfo struct {
+	Num        int    `json:"num"`
+	InputSize  int    `json:"inputSize"`
+	OutputSize int    `json:"outputSize"`
+	Sample     bool   `json:"sample"`
+	Hidden     bool   `json:"hidden"`
+	Note       string `json:"note,omitempty"`
+}
+
+//easyjson:json
+type TaskTestInfos []TaskTestInfo
+
+func (tsSQL TaskTestsSQL) ConvertToInfo() TaskTestInfos {
+	res := TaskTestInfos{}
+	for _, t := range tsSQL {
+		res = append(res, TaskTestInfo{
+			Num:        t.TestNum + 1,
+			InputSize:  t.InputSize,
+			OutputSize: t.OutputSize,
+			Sample:     t.Sample,
+			Hidden:     t.Hidden,
+			Note:       t.Note,
+		})
+	}
+	return res
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"liokoredu/application/models"
+)
+
+func TestTaskTestsJSON(t *testing.T) {
+	tests := models.TaskTests{}
+	err := json.Unmarshal([]byte(`[["1 2", "3"], ["3 4", "7", {"hidden": true}],
+		{"stdin": "5 6", "stdout": "11", "sample": true, "note": "big numbers"}]`), &tests)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tests) != 3 || tests[0].Stdin != "1 2" || !tests[1].Hidden || tests[2].Stdout != "11" ||
+		!tests[2].Sample || tests[2].Note != "big numbers" {
+		t.Errorf("wrong tests: %+v", tests)
+	}
+
+	data, _ := json.Marshal(tests)
+	if string(data) != `[["1 2","3",{}],["3 4","7",{"hidden":true}],["5 6","11",{"sample":true,"note":"big numbers"}]]` {
+		t.Errorf("wrong json of tests: %s", data)
+	}
+}
+
+func TestPublicTests(t *testing.T) {
+	tsql := models.TaskSQL{Tests: `[["1", "1"], ["2", "2"], ["3", "3"]]`, Checker: `{}`}
+	if tests := tsql.ConvertToTask(false, false).Tests; len(tests) != 2 || tests[1].Stdin != "2" {
+		t.Errorf("first two tests of an old task must be samples: %+v", tests)
+	}
+
+	tsql.Tests = `[["1", "1", {}], ["2", "2", {"hidden": true}], ["3", "3", {"sample": true}]]`
+	if tests := tsql.ConvertToTask(false, false).Tests; len(tests) != 1 || tests[0].Stdin != "3" {
+		t.Errorf("only sample tests must be shown: %+v", tests)
+	}
+	if tests := tsql.ConvertToTask(true, false).Tests; len(tests) != 3 {
+		t.Errorf("creator must see all tests: %+v", tests)
+	}
+
+	tsk := tsql.ConvertToTask(true, false)
+	runs := models.SolutionTestsSQL{{TestNum: 0, Stdout: "1"}, {TestNum: 1, Stdout: "2"}}
+	full := models.SolutionSQL{SourceCode: "{}"}.ConvertToFull(tsk, runs, false)
+	if full.Tests[0].Stdin != "1" || full.Tests[1].Stdin != "" || full.Tests[1].Stdout != "" || !full.Tests[1].Hidden {
+		t.Errorf("hidden test leaked: %+v", full.Tests)
+	}
+}
+
+func TestSubtasksScore(t *testing.T) {
+	subtasks := models.TaskSubtasks{
+		{Name: "small", Points: 20, Tests: []int{1, 2}},
+		{Name: "medium", Points: 30, Tests: []int{3}, Depends: []int{1}},
+		{Name: "large", Points: 50, Tests: []int{4, 5}, Depends: []int{2}},
+	}
+	if !subtasks.Validate() || subtasks.MaxScore() != 100 {
+		t.Fatalf("subtasks must be valid and worth 100 points")
+	}
+
+	runs := models.TestRuns{{Passed: true}, {Passed: false}, {Passed: true}, {Passed: true}, {Passed: true}}
+	score, results := subtasks.Score(runs, false)
+	if score != 0 || len(results) != 3 || results[0].Passed || !results[1].Passed || results[1].Score != 0 {
+		t.Errorf("failed dependency must fail dependent subtasks: %d %+v", score, results)
+	}
+
+	runs[1].Passed = true
+	// the last test was not run
+	score, results = subtasks.Score(runs[:4], false)
+	if score != 50 || results[2].Passed {
+		t.Errorf("wrong partial score: %d %+v", score, results)
+	}
+
+	if score, _ = (models.TaskSubtasks{}).Score(nil, true); score != 100 {
+		t.Errorf("accepted solution of a task without subtasks must get all points")
+	}
+
+	cyclic := models.TaskSubtasks{{Points: 10, Tests: []int{1}, Depends: []int{1}}}
+	if cyclic.Validate() {
+		t.Errorf("subtask can't depend on itself")
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags, ok := models.NormalizeTags([]string{" Dynamic  Programming ", "graphs", "", "dynamic programming", "C++"})
+	if !ok {
+		t.Fatalf("tags must be valid")
+	}
+	if strings.Join(tags, ",") != "c++,dynamic programming,graphs" {
+		t.Errorf("wrong normalized tags: %q", tags)
+	}
+
+	if _, ok = models.NormalizeTags([]string{"graphs; drop table"}); ok {
+		t.Errorf("tag with punctuation must be rejected")
+	}
+	if _, ok = models.NormalizeTags([]string{strings.Repeat("a", 33)}); ok {
+		t.Errorf("too long tag must be rejected")
+	}
+}
+
+func TestTextSearchQuery(t *testing.T) {
+	if q := models.TextSearchQuery("Сумма двух ч"); q != "сумма:* & двух:* & ч:*" {
+		t.Errorf("wrong query: %q", q)
+	}
+	// tsquery operators must not get into the query
+	if q := models.TextSearchQuery("graphs & !(dp) | 'x':*"); q != "graphs:* & dp:* & x:*" {
+		t.Errorf("wrong query: %q", q)
+	}
+	if q := models.TextSearchQuery(" !? "); q != "" {
+		t.Errorf("query without words must be empty: %q", q)
+	}
+}
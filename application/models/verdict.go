@@ -0,0 +1,89 @@
+package models
+
+import "strconv"
+
+// Verdict is a check result of a solution or of a single test run. Codes
+// are stored in solutions.check_result and are shared with the checker.
+type Verdict int
+
+const (
+	VerdictAccepted         Verdict = 0
+	VerdictPending          Verdict = 1
+	VerdictCompilationError Verdict = 2
+	VerdictRuntimeError     Verdict = 3
+	VerdictTimeLimit        Verdict = 4
+	// set by the update_solution trigger when tests of the task change
+	VerdictStale       Verdict = 5
+	VerdictWrongAnswer Verdict = 6
+	VerdictMemoryLimit Verdict = 7
+	VerdictOutputLimit Verdict = 8
+	VerdictCompiling   Verdict = 9
+	VerdictRunning     Verdict = 10
+	VerdictJudgeError  Verdict = 11
+)
+
+var verdictNames = map[Verdict]string{
+	VerdictAccepted:         "accepted",
+	VerdictPending:          "pending",
+	VerdictCompilationError: "compilation_error",
+	VerdictRuntimeError:     "runtime_error",
+	VerdictTimeLimit:        "time_limit_exceeded",
+	VerdictStale:            "stale",
+	VerdictWrongAnswer:      "wrong_answer",
+	VerdictMemoryLimit:      "memory_limit_exceeded",
+	VerdictOutputLimit:      "output_limit_exceeded",
+	VerdictCompiling:        "compiling",
+	VerdictRunning:          "running",
+	VerdictJudgeError:       "judge_error",
+}
+
+var verdictMessages = map[Verdict]string{
+	VerdictCompilationError: "Compilation error",
+	VerdictRuntimeError:     "Runtime error",
+	VerdictTimeLimit:        "Time limit exceeded",
+	VerdictWrongAnswer:      "Wrong answer",
+	VerdictMemoryLimit:      "Memory limit exceeded",
+	VerdictOutputLimit:      "Output limit exceeded",
+	VerdictJudgeError:       "Judge error",
+}
+
+func (v Verdict) String() string {
+	if name, ok := verdictNames[v]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// Message is a human readable description of a failed check.
+func (v Verdict) Message() string {
+	return verdictMessages[v]
+}
+
+func (v Verdict) Valid() bool {
+	_, ok := verdictNames[v]
+	return ok
+}
+
+// Final tells whether judging is over with this verdict.
+func (v Verdict) Final() bool {
+	switch v {
+	case VerdictPending, VerdictCompiling, VerdictRunning, VerdictStale:
+		return false
+	}
+	return v.Valid()
+}
+
+// ParseVerdict accepts either a name or a code of a verdict.
+func ParseVerdict(s string) (Verdict, bool) {
+	for v, name := range verdictNames {
+		if name == s {
+			return v, true
+		}
+	}
+
+	code, err := strconv.Atoi(s)
+	if err != nil || !Verdict(code).Valid() {
+		return 0, false
+	}
+	return Verdict(code), true
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"liokoredu/application/plagiarism"
+	"liokoredu/application/server/middleware"
+	"liokoredu/pkg/constants"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo"
+	"github.com/mailru/easyjson"
+)
+
+type PlagiarismHandler struct {
+	uc plagiarism.UseCase
+}
+
+func CreatePlagiarismHandler(e *echo.Echo, uc plagiarism.UseCase, a middleware.Auth) {
+	plagiarismHandler := PlagiarismHandler{
+		uc: uc,
+	}
+	e.POST("/api/v1/tasks/:id/plagiarism", plagiarismHandler.checkTask, a.GetSession)
+	e.GET("/api/v1/tasks/:id/plagiarism", plagiarismHandler.getPairs, a.GetSession)
+	e.GET("/api/v1/tasks/:id/plagiarism/report", plagiarismHandler.getReport, a.GetSession)
+	e.GET("/api/v1/tasks/:id/plagiarism/:pairId", plagiarismHandler.getPair, a.GetSession)
+}
+
+func (ph *PlagiarismHandler) checkTask(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id := c.Param(constants.IdKey)
+	iid, _ := strconv.ParseUint(string(id), 10, 64)
+
+	report, err := ph.uc.CheckTask(iid, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(report, c.Response().Writer); err != nil {
+		log.Println("plagiarism handler: checkTask: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (ph *PlagiarismHandler) getReport(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id := c.Param(constants.IdKey)
+	iid, _ := strconv.ParseUint(string(id), 10, 64)
+
+	report, err := ph.uc.GetReport(iid, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(report, c.Response().Writer); err != nil {
+		log.Println("plagiarism handler: getReport: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (ph *PlagiarismHandler) getPairs(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id := c.Param(constants.IdKey)
+	iid, _ := strconv.ParseUint(string(id), 10, 64)
+
+	pairs, err := ph.uc.GetPairs(iid, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(pairs, c.Response().Writer); err != nil {
+		log.Println("plagiarism handler: getPairs: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (ph *PlagiarismHandler) getPair(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id := c.Param(constants.IdKey)
+	iid, _ := strconv.ParseUint(string(id), 10, 64)
+	pairId := c.Param(constants.PairId)
+	pid, _ := strconv.ParseUint(string(pairId), 10, 64)
+
+	view, err := ph.uc.GetPair(iid, pid, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(view, c.Response().Writer); err != nil {
+		log.Println("plagiarism handler: getPair: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
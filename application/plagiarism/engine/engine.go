@@ -0,0 +1,261 @@
+package engine
+
+import (
+	"hash/fnv"
+	"liokoredu/application/models"
+	"liokoredu/pkg/constants"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Token is a normalized lexeme of a source file. Identifiers, numbers and
+// string literals lose their text, so renaming variables changes nothing.
+type Token struct {
+	Text string
+	File string
+	Line int
+}
+
+// Print is a winnowed hash of the k-gram starting at token Pos.
+type Print struct {
+	Hash uint64
+	Pos  int
+}
+
+// Document is a fingerprinted solution.
+type Document struct {
+	Tokens []Token
+	Prints []Print
+}
+
+// keywords of the supported languages are kept as they are, every other
+// identifier is replaced.
+var keywords = map[string]bool{}
+
+func init() {
+	for _, kw := range strings.Fields(`
+		auto break case char const continue default do double else enum extern
+		float for goto if inline int long register return short signed sizeof
+		static struct switch typedef union unsigned void volatile while bool
+		class delete new namespace operator private protected public template
+		this throw try catch using virtual nullptr true false
+		and as assert async await def del elif except finally from global import
+		in is lambda nonlocal not or pass raise with yield None True False
+		chan defer func go interface map package range select type var`) {
+		keywords[kw] = true
+	}
+}
+
+// NewDocument tokenizes text files of a solution in the order of their
+// names and computes their fingerprints.
+func NewDocument(files map[string]string) *Document {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	doc := &Document{}
+	for _, name := range names {
+		doc.Tokens = append(doc.Tokens, Tokenize(name, files[name])...)
+	}
+	doc.Prints = Winnow(doc.Tokens, constants.PlagiarismKGram, constants.PlagiarismWindow)
+
+	return doc
+}
+
+// Tokenize splits a C-like or Python source into tokens dropping spaces and
+// comments.
+func Tokenize(file string, text string) []Token {
+	src := []rune(text)
+	tokens := []Token{}
+	line := 1
+
+	for i := 0; i < len(src); {
+		r := src[i]
+		switch {
+		case r == '\n':
+			line++
+			i++
+		case unicode.IsSpace(r):
+			i++
+		case r == '#' || r == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(src) && src[i+1] == '*':
+			i += 2
+			for i < len(src) && !(src[i] == '*' && i+1 < len(src) && src[i+1] == '/') {
+				if src[i] == '\n' {
+					line++
+				}
+				i++
+			}
+			i += 2
+		case r == '"' || r == '\'' || r == '`':
+			start := line
+			i, line = skipString(src, i, line)
+			tokens = append(tokens, Token{Text: "S", File: file, Line: start})
+		case r == '_' || unicode.IsLetter(r):
+			j := i
+			for j < len(src) && (src[j] == '_' || unicode.IsLetter(src[j]) || unicode.IsDigit(src[j])) {
+				j++
+			}
+			word := string(src[i:j])
+			if !keywords[word] {
+				word = "I"
+			}
+			tokens = append(tokens, Token{Text: word, File: file, Line: line})
+			i = j
+		case unicode.IsDigit(r):
+			for i < len(src) && (src[i] == '.' || src[i] == '_' || unicode.IsLetter(src[i]) || unicode.IsDigit(src[i])) {
+				i++
+			}
+			tokens = append(tokens, Token{Text: "N", File: file, Line: line})
+		default:
+			tokens = append(tokens, Token{Text: string(r), File: file, Line: line})
+			i++
+		}
+	}
+
+	return tokens
+}
+
+// skipString moves past a string literal starting at i, Python triple quoted
+// strings included. It returns the next position and line.
+func skipString(src []rune, i int, line int) (int, int) {
+	quote := src[i]
+	triple := i+2 < len(src) && src[i+1] == quote && src[i+2] == quote
+	if triple {
+		i += 3
+		for i < len(src) && !(src[i] == quote && i+2 < len(src) && src[i+1] == quote && src[i+2] == quote) {
+			if src[i] == '\n' {
+				line++
+			}
+			i++
+		}
+		return i + 3, line
+	}
+
+	i++
+	for i < len(src) && src[i] != quote {
+		if src[i] == '\n' {
+			if quote != '`' {
+				break
+			}
+			line++
+		}
+		if src[i] == '\\' {
+			i++
+		}
+		i++
+	}
+
+	return i + 1, line
+}
+
+// Winnow hashes every k-gram of tokens and keeps the minimal hash of each
+// window of w consecutive hashes, the rightmost one on ties. A common run of
+// at least w+k-1 tokens is guaranteed to share a print.
+func Winnow(tokens []Token, k int, w int) []Print {
+	if len(tokens) < k {
+		return nil
+	}
+
+	hashes := make([]uint64, len(tokens)-k+1)
+	for i := range hashes {
+		h := fnv.New64a()
+		for _, t := range tokens[i : i+k] {
+			h.Write([]byte(t.Text))
+			h.Write([]byte{0})
+		}
+		hashes[i] = h.Sum64()
+	}
+
+	if len(hashes) < w {
+		w = len(hashes)
+	}
+	prints := []Print{}
+	for start := 0; start+w <= len(hashes); start++ {
+		min := start
+		for i := start; i < start+w; i++ {
+			if hashes[i] <= hashes[min] {
+				min = i
+			}
+		}
+		if len(prints) == 0 || prints[len(prints)-1].Pos != min {
+			prints = append(prints, Print{Hash: hashes[min], Pos: min})
+		}
+	}
+
+	return prints
+}
+
+// Compare scores similarity of two documents as the share of distinct prints
+// of the smaller one found in the other. Matching parts are returned as line
+// ranges, neighbouring ones merged.
+func Compare(a *Document, b *Document) (float64, models.PlagiarismMatches) {
+	matches := models.PlagiarismMatches{}
+
+	inB := map[uint64]int{}
+	for _, p := range b.Prints {
+		if _, ok := inB[p.Hash]; !ok {
+			inB[p.Hash] = p.Pos
+		}
+	}
+	distinctA := map[uint64]bool{}
+	common := map[uint64]bool{}
+	for _, p := range a.Prints {
+		distinctA[p.Hash] = true
+		posB, ok := inB[p.Hash]
+		if !ok {
+			continue
+		}
+		common[p.Hash] = true
+
+		fileA, startA, endA := lines(a.Tokens, p.Pos)
+		fileB, startB, endB := lines(b.Tokens, posB)
+		if n := len(matches); n > 0 {
+			last := &matches[n-1]
+			if last.FileA == fileA && last.FileB == fileB &&
+				startA <= last.EndA+1 && startB >= last.StartB && startB <= last.EndB+1 {
+				if endA > last.EndA {
+					last.EndA = endA
+				}
+				if endB > last.EndB {
+					last.EndB = endB
+				}
+				continue
+			}
+		}
+		matches = append(matches, models.PlagiarismMatch{
+			FileA: fileA, StartA: startA, EndA: endA,
+			FileB: fileB, StartB: startB, EndB: endB,
+		})
+	}
+
+	smaller := len(distinctA)
+	if len(inB) < smaller {
+		smaller = len(inB)
+	}
+	if smaller == 0 {
+		return 0, matches
+	}
+
+	return float64(len(common)) / float64(smaller), matches
+}
+
+// lines returns the file and the line range of the k-gram starting at pos.
+// A k-gram crossing a file boundary is cut at the end of its first file.
+func lines(tokens []Token, pos int) (string, int, int) {
+	end := pos + constants.PlagiarismKGram - 1
+	if end >= len(tokens) {
+		end = len(tokens) - 1
+	}
+	for tokens[end].File != tokens[pos].File {
+		end--
+	}
+
+	return tokens[pos].File, tokens[pos].Line, tokens[end].Line
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"testing"
+
+	"liokoredu/application/plagiarism/engine"
+)
+
+const original = `#include <stdio.h>
+
+int main() {
+    int n, sum = 0;
+    scanf("%d", &n);
+    for (int i = 0; i < n; i++) {
+        int x;
+        scanf("%d", &x);
+        if (x % 2 == 0) {
+            sum += x;
+        }
+    }
+    printf("%d\n", sum);
+    return 0;
+}
+`
+
+// the same program with renamed variables, other literals and comments
+const renamed = `#include <stdio.h>
+// sums even numbers
+int main() {
+    int count, total = 0;
+    scanf("%d", &count);
+    for (int k = 0; k < count; k++) {
+        int value; /* next number */
+        scanf("%d", &value);
+        if (value % 2 == 0) {
+            total += value;
+        }
+    }
+    printf("total: %d\n", total);
+    return 0;
+}
+`
+
+const different = `#include <stdio.h>
+
+int main() {
+    char s[100];
+    while (fgets(s, sizeof(s), stdin) != NULL) {
+        puts(s);
+    }
+    return 0;
+}
+`
+
+func TestTokenize(t *testing.T) {
+	tokens := engine.Tokenize("main.py", "x = 'a' # comment\nif x: print(42)\n")
+
+	texts := ""
+	for _, tok := range tokens {
+		texts += tok.Text + " "
+	}
+	if texts != "I = S if I : I ( N ) " {
+		t.Errorf("wrong tokens: %q", texts)
+	}
+	if tokens[3].Line != 2 {
+		t.Errorf("wrong line of token: %d", tokens[3].Line)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a := engine.NewDocument(map[string]string{"main.c": original})
+	b := engine.NewDocument(map[string]string{"main.c": renamed})
+	c := engine.NewDocument(map[string]string{"main.c": different})
+
+	score, matches := engine.Compare(a, b)
+	if score != 1 {
+		t.Errorf("renamed copy scored %v", score)
+	}
+	// winnowing may skip a few tokens at the very end
+	if len(matches) != 1 || matches[0].StartA != 3 || matches[0].EndA < 13 ||
+		matches[0].StartB != 3 || matches[0].EndB != matches[0].EndA {
+		t.Errorf("wrong matches of renamed copy: %+v", matches)
+	}
+
+	if score, _ = engine.Compare(a, c); score > 0.3 {
+		t.Errorf("different programs scored %v", score)
+	}
+}
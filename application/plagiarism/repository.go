@@ -0,0 +1,19 @@
+package plagiarism
+
+import (
+	"liokoredu/application/models"
+	"time"
+)
+
+type Repository interface {
+	GetAcceptedSolutions(taskId uint64) (models.SolutionsSQL, error)
+	GetSourceCode(solutionId uint64) (map[string]interface{}, error)
+	ReplacePairs(taskId uint64, pairs models.PlagiarismPairsSQL) error
+	GetPairs(taskId uint64) (models.PlagiarismPairsSQL, error)
+	GetPair(taskId uint64, id uint64) (models.PlagiarismPairSQL, error)
+	QueueCheck(taskId uint64) error
+	ClaimCheck(timeout time.Duration) (uint64, bool, error)
+	SaveReport(r models.PlagiarismReport) error
+	FailCheck(taskId uint64, reason string) error
+	GetReport(taskId uint64) (models.PlagiarismReport, error)
+}
@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"liokoredu/application/blobstore"
+	"liokoredu/application/models"
+	"liokoredu/application/plagiarism"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/labstack/echo"
+)
+
+type PlagiarismDatabase struct {
+	pool  *pgxpool.Pool
+	blobs blobstore.Store
+}
+
+// GetAcceptedSolutions implements plagiarism.Repository
+// Only the latest accepted solution of every user in every language is
+// returned, with the source code loaded.
+func (pd *PlagiarismDatabase) GetAcceptedSolutions(taskId uint64) (models.SolutionsSQL, error) {
+	var sln models.SolutionsSQL
+	err := pgxscan.Select(context.Background(), pd.pool, &sln,
+		`SELECT DISTINCT ON (uid, language) * FROM solutions
+		WHERE task_id = $1 AND check_result = $2 ORDER BY uid, language, id DESC`,
+		taskId, models.VerdictAccepted)
+	if err != nil {
+		log.Println("plagiarism repo: GetAcceptedSolutions: error getting solutions:", err)
+		return models.SolutionsSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	for i := range sln {
+		code, err := pd.blobs.Get(sln[i].SourceCode)
+		if err != nil {
+			log.Println("plagiarism repo: GetAcceptedSolutions: error reading source code of solution", sln[i].Id, err)
+			return models.SolutionsSQL{}, echo.NewHTTPError(http.StatusInternalServerError, "source code of solution is lost")
+		}
+		sln[i].SourceCode = string(code)
+	}
+
+	return sln, nil
+}
+
+func (pd *PlagiarismDatabase) GetSourceCode(solutionId uint64) (map[string]interface{}, error) {
+	var key string
+	err := pd.pool.QueryRow(context.Background(),
+		`SELECT source_code FROM solutions WHERE id = $1`, solutionId).Scan(&key)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "solution not found")
+	}
+	if err != nil {
+		log.Println("plagiarism repo: GetSourceCode: error getting solution:", err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	data, err := pd.blobs.Get(key)
+	if err != nil {
+		log.Println("plagiarism repo: GetSourceCode: error reading source code of solution", solutionId, err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "source code of solution is lost")
+	}
+
+	code := map[string]interface{}{}
+	if err = json.Unmarshal(data, &code); err != nil {
+		log.Println("plagiarism repo: GetSourceCode: broken source code of solution", solutionId, err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return code, nil
+}
+
+// ReplacePairs implements plagiarism.Repository
+// Results of the previous check of the task are dropped.
+func (pd *PlagiarismDatabase) ReplacePairs(taskId uint64, pairs models.PlagiarismPairsSQL) error {
+	tx, err := pd.pool.Begin(context.Background())
+	if err != nil {
+		log.Println("plagiarism repo: ReplacePairs: error starting transaction:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer tx.Rollback(context.Background())
+
+	_, err = tx.Exec(context.Background(), `DELETE FROM plagiarism_pairs WHERE task_id = $1`, taskId)
+	if err != nil {
+		log.Println("plagiarism repo: ReplacePairs: error deleting old pairs:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	for _, p := range pairs {
+		_, err = tx.Exec(context.Background(),
+			`INSERT INTO plagiarism_pairs (task_id, language, solution_a, uid_a, solution_b, uid_b,
+			score, matches, checked_date_time) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			taskId, p.Language, p.SolutionA, p.UidA, p.SolutionB, p.UidB, p.Score, p.Matches, p.CheckedDateTime)
+		if err != nil {
+			log.Println("plagiarism repo: ReplacePairs: error inserting pair:", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("plagiarism repo: ReplacePairs: error committing:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+const selectPairs = `SELECT p.id, p.task_id, p.language, p.solution_a, p.uid_a, ua.username AS username_a,
+	p.solution_b, p.uid_b, ub.username AS username_b, p.score, p.matches, p.checked_date_time
+	FROM plagiarism_pairs p JOIN users ua ON ua.id = p.uid_a JOIN users ub ON ub.id = p.uid_b`
+
+func (pd *PlagiarismDatabase) GetPairs(taskId uint64) (models.PlagiarismPairsSQL, error) {
+	var pairs models.PlagiarismPairsSQL
+	err := pgxscan.Select(context.Background(), pd.pool, &pairs,
+		selectPairs+` WHERE p.task_id = $1 ORDER BY p.score DESC, p.id`, taskId)
+	if err != nil {
+		log.Println("plagiarism repo: GetPairs: error getting pairs:", err)
+		return models.PlagiarismPairsSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return pairs, nil
+}
+
+func (pd *PlagiarismDatabase) GetPair(taskId uint64, id uint64) (models.PlagiarismPairSQL, error) {
+	var pairs models.PlagiarismPairsSQL
+	err := pgxscan.Select(context.Background(), pd.pool, &pairs,
+		selectPairs+` WHERE p.task_id = $1 AND p.id = $2`, taskId, id)
+	if err != nil {
+		log.Println("plagiarism repo: GetPair: error getting pair:", err)
+		return models.PlagiarismPairSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if len(pairs) == 0 {
+		return models.PlagiarismPairSQL{}, echo.NewHTTPError(http.StatusNotFound, "pair of solutions not found")
+	}
+
+	return pairs[0], nil
+}
+
+const selectReport = `SELECT task_id, status, solutions, pairs, error, requested_date_time, checked_date_time
+	FROM plagiarism_reports`
+
+// QueueCheck implements plagiarism.Repository
+// A check which is already queued or running is left as is.
+func (pd *PlagiarismDatabase) QueueCheck(taskId uint64) error {
+	_, err := pd.pool.Exec(context.Background(),
+		`INSERT INTO plagiarism_reports (task_id, status, requested_date_time) VALUES ($1, $2, now())
+		ON CONFLICT (task_id) DO UPDATE SET status = $2, error = '', requested_date_time = now()
+		WHERE plagiarism_reports.status NOT IN ($2, $3)`,
+		taskId, models.PlagiarismStatusQueued, models.PlagiarismStatusRunning)
+	if err != nil {
+		log.Println("plagiarism repo: QueueCheck: error queueing check:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+// ClaimCheck implements plagiarism.Repository
+// The oldest queued check is switched to running, checks running for longer
+// than timeout are taken over as their worker is considered dead. Concurrent
+// callers never get the same check.
+func (pd *PlagiarismDatabase) ClaimCheck(timeout time.Duration) (uint64, bool, error) {
+	var taskId uint64
+	err := pd.pool.QueryRow(context.Background(),
+		`UPDATE plagiarism_reports SET status = $2, started_date_time = now() WHERE task_id IN (
+			SELECT task_id FROM plagiarism_reports
+			WHERE status = $1 OR (status = $2 AND started_date_time < now() - $3::interval)
+			ORDER BY requested_date_time LIMIT 1 FOR UPDATE SKIP LOCKED)
+		RETURNING task_id`,
+		models.PlagiarismStatusQueued, models.PlagiarismStatusRunning, timeout.String()).Scan(&taskId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		log.Println("plagiarism repo: ClaimCheck: error claiming check:", err)
+		return 0, false, err
+	}
+
+	return taskId, true, nil
+}
+
+// SaveReport implements plagiarism.Repository
+func (pd *PlagiarismDatabase) SaveReport(r models.PlagiarismReport) error {
+	_, err := pd.pool.Exec(context.Background(),
+		`UPDATE plagiarism_reports SET status = $2, solutions = $3, pairs = $4, error = '',
+		checked_date_time = $5 WHERE task_id = $1`,
+		r.TaskId, r.Status, r.Solutions, r.Pairs, r.CheckedDateTime)
+	if err != nil {
+		log.Println("plagiarism repo: SaveReport: error saving report:", err)
+		return err
+	}
+
+	return nil
+}
+
+// FailCheck implements plagiarism.Repository
+// Totals of the last finished check are kept.
+func (pd *PlagiarismDatabase) FailCheck(taskId uint64, reason string) error {
+	_, err := pd.pool.Exec(context.Background(),
+		`UPDATE plagiarism_reports SET status = $2, error = $3 WHERE task_id = $1`,
+		taskId, models.PlagiarismStatusFailed, reason)
+	if err != nil {
+		log.Println("plagiarism repo: FailCheck: error saving failure:", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetReport implements plagiarism.Repository
+func (pd *PlagiarismDatabase) GetReport(taskId uint64) (models.PlagiarismReport, error) {
+	var reports []models.PlagiarismReport
+	err := pgxscan.Select(context.Background(), pd.pool, &reports, selectReport+` WHERE task_id = $1`, taskId)
+	if err != nil {
+		log.Println("plagiarism repo: GetReport: error getting report:", err)
+		return models.PlagiarismReport{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if len(reports) == 0 {
+		return models.PlagiarismReport{}, echo.NewHTTPError(http.StatusNotFound, "task was never checked for plagiarism")
+	}
+
+	return reports[0], nil
+}
+
+func NewPlagiarismDatabase(conn *pgxpool.Pool, blobs blobstore.Store) plagiarism.Repository {
+	return &PlagiarismDatabase{pool: conn, blobs: blobs}
+}
@@ -0,0 +1,11 @@
+package plagiarism
+
+import "liokoredu/application/models"
+
+type UseCase interface {
+	CheckTask(taskId uint64, uid uint64) (models.PlagiarismReport, error)
+	GetReport(taskId uint64, uid uint64) (models.PlagiarismReport, error)
+	RunQueuedChecks() error
+	GetPairs(taskId uint64, uid uint64) (models.PlagiarismPairs, error)
+	GetPair(taskId uint64, id uint64, uid uint64) (models.PlagiarismView, error)
+}
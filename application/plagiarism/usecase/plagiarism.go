@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"encoding/json"
+	"liokoredu/application/models"
+	"liokoredu/application/plagiarism"
+	"liokoredu/application/plagiarism/engine"
+	"liokoredu/application/task"
+	"liokoredu/pkg/constants"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo"
+)
+
+type PlagiarismUseCase struct {
+	repo   plagiarism.Repository
+	ucTask task.UseCase
+}
+
+func (puc *PlagiarismUseCase) checkRights(taskId uint64, uid uint64) error {
+	allowed, err := puc.ucTask.CanManageTask(taskId, uid)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return echo.NewHTTPError(http.StatusForbidden, "only the author of the task can check it for plagiarism")
+	}
+
+	return nil
+}
+
+// CheckTask implements plagiarism.UseCase
+// The check is only queued, it is run by RunQueuedChecks in background.
+func (puc *PlagiarismUseCase) CheckTask(taskId uint64, uid uint64) (models.PlagiarismReport, error) {
+	if err := puc.checkRights(taskId, uid); err != nil {
+		return models.PlagiarismReport{}, err
+	}
+
+	if err := puc.repo.QueueCheck(taskId); err != nil {
+		return models.PlagiarismReport{}, err
+	}
+
+	return puc.repo.GetReport(taskId)
+}
+
+// GetReport implements plagiarism.UseCase
+func (puc *PlagiarismUseCase) GetReport(taskId uint64, uid uint64) (models.PlagiarismReport, error) {
+	if err := puc.checkRights(taskId, uid); err != nil {
+		return models.PlagiarismReport{}, err
+	}
+
+	return puc.repo.GetReport(taskId)
+}
+
+// RunQueuedChecks implements plagiarism.UseCase
+// Queued checks are run one by one until none is left, a failed check keeps
+// the totals of the previous one and is not retried until requested again.
+func (puc *PlagiarismUseCase) RunQueuedChecks() error {
+	for {
+		taskId, ok, err := puc.repo.ClaimCheck(constants.PlagiarismCheckTimeout)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+
+		report, err := puc.check(taskId)
+		if err != nil {
+			log.Println("plagiarism usecase: RunQueuedChecks: check of task", taskId, "failed:", err)
+			if err = puc.repo.FailCheck(taskId, err.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = puc.repo.SaveReport(report); err != nil {
+			return err
+		}
+	}
+}
+
+// check compares accepted solutions pairwise within a language, solutions
+// of the same user are never paired.
+func (puc *PlagiarismUseCase) check(taskId uint64) (models.PlagiarismReport, error) {
+	slns, err := puc.repo.GetAcceptedSolutions(taskId)
+	if err != nil {
+		return models.PlagiarismReport{}, err
+	}
+
+	docs := make([]*engine.Document, len(slns))
+	for i, sln := range slns {
+		code := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(sln.SourceCode), &code); err != nil {
+			log.Println("plagiarism usecase: check: broken source code of solution", sln.Id, err)
+			continue
+		}
+		files := map[string]string{}
+		for name, content := range code {
+			if text, ok := content.(string); ok {
+				files[name] = text
+			}
+		}
+		docs[i] = engine.NewDocument(files)
+	}
+
+	now := time.Now()
+	pairs := models.PlagiarismPairsSQL{}
+	for i := range slns {
+		for j := i + 1; j < len(slns); j++ {
+			if docs[i] == nil || docs[j] == nil ||
+				slns[i].Language != slns[j].Language || slns[i].Uid == slns[j].Uid {
+				continue
+			}
+
+			score, matches := engine.Compare(docs[i], docs[j])
+			if score < constants.PlagiarismThreshold {
+				continue
+			}
+			data, err := json.Marshal(matches)
+			if err != nil {
+				return models.PlagiarismReport{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+			}
+
+			pairs = append(pairs, models.PlagiarismPairSQL{
+				TaskId:          taskId,
+				Language:        slns[i].Language,
+				SolutionA:       slns[i].Id,
+				UidA:            slns[i].Uid,
+				SolutionB:       slns[j].Id,
+				UidB:            slns[j].Uid,
+				Score:           score,
+				Matches:         string(data),
+				CheckedDateTime: now,
+			})
+		}
+	}
+
+	if err = puc.repo.ReplacePairs(taskId, pairs); err != nil {
+		return models.PlagiarismReport{}, err
+	}
+
+	return models.PlagiarismReport{
+		TaskId:          taskId,
+		Status:          models.PlagiarismStatusDone,
+		Solutions:       len(slns),
+		Pairs:           len(pairs),
+		CheckedDateTime: &now,
+	}, nil
+}
+
+// GetPairs implements plagiarism.UseCase
+func (puc *PlagiarismUseCase) GetPairs(taskId uint64, uid uint64) (models.PlagiarismPairs, error) {
+	if err := puc.checkRights(taskId, uid); err != nil {
+		return models.PlagiarismPairs{}, err
+	}
+
+	pairs, err := puc.repo.GetPairs(taskId)
+	if err != nil {
+		return models.PlagiarismPairs{}, err
+	}
+
+	return pairs.ConvertToJson(), nil
+}
+
+// GetPair implements plagiarism.UseCase
+func (puc *PlagiarismUseCase) GetPair(taskId uint64, id uint64, uid uint64) (models.PlagiarismView, error) {
+	if err := puc.checkRights(taskId, uid); err != nil {
+		return models.PlagiarismView{}, err
+	}
+
+	pair, err := puc.repo.GetPair(taskId, id)
+	if err != nil {
+		return models.PlagiarismView{}, err
+	}
+	sourceA, err := puc.repo.GetSourceCode(pair.SolutionA)
+	if err != nil {
+		return models.PlagiarismView{}, err
+	}
+	sourceB, err := puc.repo.GetSourceCode(pair.SolutionB)
+	if err != nil {
+		return models.PlagiarismView{}, err
+	}
+
+	view, err := pair.ConvertToView(sourceA, sourceB)
+	if err != nil {
+		log.Println("plagiarism usecase: GetPair: broken matches of pair", id, err)
+		return models.PlagiarismView{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return view, nil
+}
+
+func NewPlagiarismUseCase(p plagiarism.Repository, t task.UseCase) plagiarism.UseCase {
+	return &PlagiarismUseCase{repo: p, ucTask: t}
+}
@@ -0,0 +1,34 @@
+package worker
+
+import (
+	"liokoredu/application/plagiarism"
+	"liokoredu/pkg/constants"
+	"log"
+	"time"
+)
+
+// Worker runs queued plagiarism checks in background.
+type Worker struct {
+	puc plagiarism.UseCase
+}
+
+func NewWorker(puc plagiarism.UseCase) *Worker {
+	return &Worker{puc: puc}
+}
+
+// Start launches the worker in background.
+func (w *Worker) Start() {
+	go w.run()
+	log.Println("plagiarism worker: started")
+}
+
+func (w *Worker) run() {
+	ticker := time.NewTicker(constants.PlagiarismSweepInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := w.puc.RunQueuedChecks(); err != nil {
+			log.Println("plagiarism worker: run: error running checks:", err)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"bytes"
+	"io/ioutil"
+	"liokoredu/application/checker"
+	"liokoredu/application/models"
+	"liokoredu/application/solution"
+	"liokoredu/pkg/constants"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo"
+)
+
+type CheckerAuth struct {
+	suc    solution.UseCase
+	secret []byte
+}
+
+func NewCheckerAuth(suc solution.UseCase, secret string) CheckerAuth {
+	if secret == "" {
+		log.Println("middleware: NewCheckerAuth: no checker secret, all checker callbacks will be rejected")
+	}
+	return CheckerAuth{suc: suc, secret: []byte(secret)}
+}
+
+// VerifyCallback lets through only checker callbacks signed with the shared
+// secret, fresh, carrying the nonce issued for the solution and not seen
+// before. Rejected callbacks are written to the audit trail.
+func (ca CheckerAuth) VerifyCallback(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		id, _ := strconv.ParseUint(ctx.Param(constants.IdKey), 10, 64)
+		timestamp := ctx.Request().Header.Get(checker.TimestampHeader)
+		nonce := ctx.Request().Header.Get(checker.NonceHeader)
+		signature := ctx.Request().Header.Get(checker.SignatureHeader)
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(ctx.Response(), ctx.Request().Body, constants.CheckerCallbackMaxSize))
+		if err != nil {
+			log.Println("middleware: VerifyCallback: error reading body", err.Error())
+			return echo.NewHTTPError(http.StatusBadRequest, "Error reading body")
+		}
+		ctx.Request().Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		reject := func(reason string) error {
+			ca.suc.AuditCallback(models.CallbackAudit{
+				SolutionId: id,
+				RemoteAddr: ctx.RealIP(),
+				Reason:     reason,
+				Signature:  signature,
+				Body:       string(body),
+			})
+			return echo.NewHTTPError(http.StatusUnauthorized, "Callback rejected")
+		}
+
+		if len(ca.secret) == 0 {
+			return reject("checker secret is not configured")
+		}
+		if timestamp == "" || nonce == "" || signature == "" {
+			return reject("missing signature headers")
+		}
+
+		sec, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return reject("malformed timestamp")
+		}
+		age := time.Since(time.Unix(sec, 0))
+		if age > constants.CheckerCallbackWindow || age < -constants.CheckerCallbackWindow {
+			return reject("timestamp out of window")
+		}
+
+		if !checker.Verify(ca.secret, timestamp, nonce, body, signature) {
+			return reject("bad signature")
+		}
+
+		if err = ca.suc.CheckCallback(id, nonce, signature); err != nil {
+			if httpErr, ok := err.(*echo.HTTPError); ok && httpErr.Code == http.StatusUnauthorized {
+				return reject(httpErr.Message.(string))
+			}
+			return err
+		}
+
+		return next(ctx)
+	}
+}
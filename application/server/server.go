@@ -3,12 +3,29 @@ package server
 import (
 	"context"
 	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/gomodule/redigo/redis"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/labstack/echo"
 	"github.com/petejkim/ot.go/ot"
 
+	"liokoredu/application/blobstore"
+	blocal "liokoredu/application/blobstore/local"
+	"liokoredu/application/blobstore/s3"
+	"liokoredu/application/checker"
+	"liokoredu/application/checker/local"
+	"liokoredu/application/checker/remote"
+	jrep "liokoredu/application/judge/repository"
+	"liokoredu/application/judge/worker"
+	lhttp "liokoredu/application/language/delivery/http"
+	phttp "liokoredu/application/plagiarism/delivery/http"
+	prep "liokoredu/application/plagiarism/repository"
+	puc "liokoredu/application/plagiarism/usecase"
+	pworker "liokoredu/application/plagiarism/worker"
 	"liokoredu/application/server/middleware"
 	slhttp "liokoredu/application/solution/delivery/http"
 	slrep "liokoredu/application/solution/repository"
@@ -58,15 +75,58 @@ func NewServer() *Server {
 	}
 
 	userRep := urep.NewUserDatabase(redisPool, pool)
-	solutionRep := slrep.NewSolutionDatabase(pool)
-	taskRep := trep.NewTaskDatabase(pool)
+	var blobs blobstore.Store
+	if os.Getenv(constants.BlobStoreEnv) == "s3" {
+		blobs = s3.NewS3Store(s3.Config{
+			Endpoint:  os.Getenv(constants.S3EndpointEnv),
+			Bucket:    os.Getenv(constants.S3BucketEnv),
+			Region:    os.Getenv(constants.S3RegionEnv),
+			AccessKey: os.Getenv(constants.S3AccessKeyEnv),
+			SecretKey: os.Getenv(constants.S3SecretKeyEnv),
+		})
+	} else {
+		base, _ := os.Getwd()
+		blobs, err = blocal.NewLocalStore(filepath.ToSlash(base + constants.SolutionsDir))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	solutionRep := slrep.NewSolutionDatabase(redisPool, pool, blobs)
+	taskRep := trep.NewTaskDatabase(pool, blobs)
+	plagiarismRep := prep.NewPlagiarismDatabase(pool, blobs)
 
 	userUC := uuc.NewUserUseCase(userRep)
 
-	taskUC := tuc.NewTaskUseCase(taskRep)
-	solutionUC := sluc.NewSolutionUseCase(solutionRep, taskUC)
+	var judgeChecker checker.Checker
+	if os.Getenv(constants.CheckerBackendEnv) == "local" {
+		judgeChecker = local.NewLocalChecker(local.Config{
+			Wrapper:    strings.Fields(os.Getenv(constants.JudgeWrapperEnv)),
+			Namespaces: os.Getenv(constants.JudgeNamespacesEnv) != "",
+		})
+	} else {
+		judgeChecker = remote.NewRemoteChecker(constants.PythonAddress)
+	}
+	taskUC := tuc.NewTaskUseCase(taskRep, judgeChecker)
+	plagiarismUC := puc.NewPlagiarismUseCase(plagiarismRep, taskUC)
+	judgeQueue := jrep.NewJudgeQueue(redisPool)
+	solutionUC := sluc.NewSolutionUseCase(solutionRep, taskUC, judgeChecker, judgeQueue)
+
+	judgeWorkers := constants.JudgeWorkers
+	if env := os.Getenv(constants.JudgeWorkersEnv); env != "" {
+		judgeWorkers, err = strconv.Atoi(env)
+		if err != nil || judgeWorkers < 1 {
+			log.Fatal("wrong number of judge workers in ", constants.JudgeWorkersEnv, ": ", env)
+		}
+	}
+	judgePool := worker.NewPool(judgeQueue, solutionUC, judgeWorkers)
+	judgePool.Start()
+
+	plagiarismWorker := pworker.NewWorker(plagiarismUC)
+	plagiarismWorker.Start()
 
 	a := middleware.NewAuth(userUC)
+	ca := middleware.NewCheckerAuth(solutionUC, os.Getenv(constants.CheckerSecretEnv))
 
 	//rpcR, err := client.NewRedactorClient(constants.RedactorServicePort)
 	//if err != nil {
@@ -74,8 +134,11 @@ func NewServer() *Server {
 	//}
 
 	uhttp.CreateUserHandler(e, userUC, a)
-	slhttp.CreateSolutionHandler(e, solutionUC, taskUC, userUC)
+	slhttp.CreateSolutionHandler(e, solutionUC, taskUC, userUC, ca)
+	slhttp.CreateReviewHandler(e, solutionUC, a)
 	thttp.CreateTaskHandler(e, taskUC, userUC, a)
+	phttp.CreatePlagiarismHandler(e, plagiarismUC, a)
+	lhttp.CreateLanguageHandler(e)
 	rhttp.CreateRedactorHandler(e, a)
 
 	server.e = e
@@ -0,0 +1,122 @@
+package http
+
+import (
+	"liokoredu/application/models"
+	"liokoredu/application/server/middleware"
+	"liokoredu/application/solution"
+	"liokoredu/pkg/constants"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo"
+	"github.com/mailru/easyjson"
+)
+
+type ReviewHandler struct {
+	uc solution.UseCase
+}
+
+func CreateReviewHandler(e *echo.Echo, uc solution.UseCase, a middleware.Auth) {
+	reviewHandler := ReviewHandler{
+		uc: uc,
+	}
+	e.GET("/api/v1/tasks/:taskId/solutions/:solutionId/reviews", reviewHandler.getReviews, a.GetSession)
+	e.POST("/api/v1/tasks/:taskId/solutions/:solutionId/reviews", reviewHandler.createThread, a.GetSession)
+	e.POST("/api/v1/tasks/:taskId/solutions/:solutionId/reviews/:threadId/comments", reviewHandler.reply, a.GetSession)
+	e.PUT("/api/v1/tasks/:taskId/solutions/:solutionId/reviews/:threadId", reviewHandler.resolve, a.GetSession)
+}
+
+func reviewParams(c echo.Context) (uint64, uint64, uint64) {
+	taskId, _ := strconv.ParseUint(c.Param(constants.TaskId), 10, 64)
+	solId, _ := strconv.ParseUint(c.Param(constants.SolutionId), 10, 64)
+	threadId, _ := strconv.ParseUint(c.Param(constants.ThreadId), 10, 64)
+	return taskId, solId, threadId
+}
+
+func (rh *ReviewHandler) getReviews(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	taskId, solId, _ := reviewParams(c)
+
+	threads, err := rh.uc.GetReviews(taskId, solId, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(threads, c.Response().Writer); err != nil {
+		log.Println("review handler: getReviews: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (rh *ReviewHandler) createThread(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	taskId, solId, _ := reviewParams(c)
+
+	th := &models.ReviewThreadNew{}
+	if err := easyjson.UnmarshalFromReader(c.Request().Body, th); err != nil {
+		log.Println("review handler: createThread: error unmarshaling thread", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	thread, err := rh.uc.CreateReviewThread(taskId, solId, uid, th)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(thread, c.Response().Writer); err != nil {
+		log.Println("review handler: createThread: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (rh *ReviewHandler) reply(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	taskId, solId, threadId := reviewParams(c)
+
+	r := &models.ReviewReply{}
+	if err := easyjson.UnmarshalFromReader(c.Request().Body, r); err != nil {
+		log.Println("review handler: reply: error unmarshaling reply", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	comment, err := rh.uc.ReplyReview(taskId, solId, threadId, uid, r.Text)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(comment, c.Response().Writer); err != nil {
+		log.Println("review handler: reply: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (rh *ReviewHandler) resolve(c echo.Context) error {
+	defer c.Request().Body.Close()
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	taskId, solId, threadId := reviewParams(c)
+
+	r := &models.ReviewResolve{}
+	if err := easyjson.UnmarshalFromReader(c.Request().Body, r); err != nil {
+		log.Println("review handler: resolve: error unmarshaling resolve", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return rh.uc.ResolveReview(taskId, solId, threadId, uid, r.Resolved)
+}
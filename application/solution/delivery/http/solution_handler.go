@@ -1,10 +1,8 @@
 package http
 
 import (
-	"bytes"
-	"encoding/json"
-	"io/ioutil"
 	"liokoredu/application/models"
+	"liokoredu/application/server/middleware"
 	"liokoredu/application/solution"
 	"liokoredu/application/task"
 	"liokoredu/application/user"
@@ -12,6 +10,7 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo"
 	"github.com/mailru/easyjson"
@@ -24,18 +23,24 @@ type SolutionHandler struct {
 }
 
 func CreateSolutionHandler(e *echo.Echo,
-	uc solution.UseCase, tuc task.UseCase, uuc user.UseCase) {
+	uc solution.UseCase, tuc task.UseCase, uuc user.UseCase, ca middleware.CheckerAuth) {
 	solutionHandler := SolutionHandler{
 		UseCase:  uc,
 		TUseCase: tuc,
 		uuc:      uuc,
 	}
 	e.POST("/api/v1/tasks/:id/solutions", solutionHandler.PostSolution)
-	e.POST("/api/v1/solutions/update/:id", solutionHandler.UpdateSolution)
+	e.POST("/api/v1/tasks/:id/run", solutionHandler.runSolution)
+	e.POST("/api/v1/solutions/update/:id", solutionHandler.UpdateSolution, ca.VerifyCallback)
 	e.GET("/api/v1/tasks/:id/solutions", solutionHandler.GetSolutions)
+	e.GET("/api/v1/solutions", solutionHandler.getUserSolutions)
 	e.GET("/api/v1/tasks/:taskId/solutions/:solutionId", solutionHandler.getSolution)
+	e.GET("/api/v1/tasks/:taskId/solutions/:solutionId/diff/:otherId", solutionHandler.diffSolutions)
 	e.PUT("/api/v1/tasks/:taskId/solutions/:solutionId", solutionHandler.rerunSolution)
 	e.DELETE("/api/v1/tasks/:taskId/solutions/:solutionId", solutionHandler.deleteSolution)
+	e.GET("/api/v1/ws/solutions", solutionHandler.subscribeSolutions)
+	e.POST("/api/v1/tasks/:id/rejudge", solutionHandler.rejudgeTask)
+	e.GET("/api/v1/tasks/:id/rejudge", solutionHandler.getRejudgeStatus)
 }
 
 func (sh SolutionHandler) PostSolution(c echo.Context) error {
@@ -72,56 +77,12 @@ func (sh SolutionHandler) PostSolution(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusTeapot, err.Error())
 	}
 
-	task, err := sh.TUseCase.GetTask(iid, uid, true)
+	solId, err := sh.UseCase.SubmitSolution(iid, uid, sln.Language, sln.SourceCode)
 	if err != nil {
 		return err
 	}
-	testAmount := task.TestsAmount
 
-	solId, err := sh.UseCase.InsertSolution(iid, uid, sln.SourceCode, testAmount)
-	if err != nil {
-		return err
-	}
-
-	ss := models.SolutionSend{
-		Id:         solId,
-		SourceCode: sln.SourceCode,
-		Tests:      models.InputTests(task.Tests),
-	}
-
-	reqBody, err := json.Marshal(ss)
-	if err != nil {
-		log.Println("user handler: postSolution: error marshaling SolutionSend", err)
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
-	}
-
-	resp, err := http.Post(constants.PythonAddress,
-		"application/json", bytes.NewBuffer(reqBody))
-	if err != nil {
-		print(err)
-	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		print(err)
-	}
-
-	update := &models.SolutionUpdate{}
-
-	_ = json.Unmarshal(body, update)
-	err = sh.UseCase.UpdateSolution(solId, *update)
-	if err != nil {
-		return err
-	}
-
-	if update.Code == 0 {
-		err = sh.TUseCase.MarkTaskDone(iid, uid)
-		if err != nil {
-			return err
-		}
-	}
-
-	ans := &models.ReturnId{Id: solId}
+	ans := &models.SolutionPosted{Id: solId, CheckResult: models.VerdictPending}
 	if _, err = easyjson.MarshalToWriter(ans, c.Response().Writer); err != nil {
 		log.Println(c, err)
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -135,7 +96,7 @@ func (sh SolutionHandler) UpdateSolution(c echo.Context) error {
 	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
 
 	id := c.Param(constants.IdKey)
-	uid, _ := strconv.ParseUint(string(id), 10, 64)
+	solId, _ := strconv.ParseUint(string(id), 10, 64)
 
 	info := &models.SolutionUpdate{}
 	if err := easyjson.UnmarshalFromReader(c.Request().Body, info); err != nil {
@@ -143,7 +104,7 @@ func (sh SolutionHandler) UpdateSolution(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusTeapot, err.Error())
 	}
 
-	err := sh.UseCase.UpdateSolution(uid, *info)
+	err := sh.UseCase.UpdateSolution(solId, *info)
 	if err != nil {
 		return err
 	}
@@ -182,47 +143,53 @@ func (sh SolutionHandler) rerunSolution(c echo.Context) error {
 	solId := c.Param(constants.SolutionId)
 	usolId, _ := strconv.ParseUint(string(solId), 10, 64)
 
-	sln, err := sh.UseCase.GetSolution(usolId, utid, uid)
-	if err != nil {
-		return err
-	}
-	tsk, err := sh.TUseCase.GetTask(utid, uid, true)
-	if err != nil {
-		return err
-	}
+	return sh.UseCase.RerunSolution(usolId, utid, uid)
+}
 
-	ss := models.SolutionSend{
-		Id:         usolId,
-		SourceCode: sln.SourceCode,
-		Tests:      models.InputTests(tsk.Tests),
+func (sh SolutionHandler) runSolution(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	cookie, err := c.Cookie(constants.SessionCookieName)
+	if err != nil && cookie != nil {
+		log.Println("solution handler: runSolution: error getting cookie")
+		return echo.NewHTTPError(http.StatusBadRequest, "error getting cookie")
 	}
 
-	reqBody, err := json.Marshal(ss)
-	if err != nil {
-		log.Println("user handler: rerunSolution: error marshaling SolutionSend", err)
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	if cookie == nil {
+		log.Println("solution handler: runSolution: no cookie")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
 	}
 
-	resp, err := http.Post(constants.PythonAddress,
-		"application/json", bytes.NewBuffer(reqBody))
+	uid, err := sh.uuc.CheckSession(cookie.Value)
 	if err != nil {
-		print(err)
+		return err
 	}
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		print(err)
+
+	if uid == 0 {
+		log.Println("solution handler: runSolution: uid 0")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
 	}
 
-	update := &models.SolutionUpdate{}
+	run := &models.RunRequest{}
+	id := c.Param(constants.IdKey)
+	iid, _ := strconv.ParseUint(string(id), 10, 64)
 
-	_ = json.Unmarshal(body, update)
-	log.Println(string(body[:]))
-	err = sh.UseCase.UpdateSolution(usolId, *update)
+	if err := easyjson.UnmarshalFromReader(c.Request().Body, run); err != nil {
+		log.Println("solution handler: runSolution: error unmarshaling run", err)
+		return echo.NewHTTPError(http.StatusTeapot, err.Error())
+	}
+
+	res, err := sh.UseCase.RunSolution(iid, uid, run)
 	if err != nil {
 		return err
 	}
 
+	if _, err = easyjson.MarshalToWriter(res, c.Response().Writer); err != nil {
+		log.Println("solution handler: runSolution: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
 	return nil
 }
 
@@ -254,10 +221,63 @@ func (sh SolutionHandler) GetSolutions(c echo.Context) error {
 	id := c.Param(constants.IdKey)
 	iid, _ := strconv.ParseUint(string(id), 10, 64)
 
-	slns, err := sh.UseCase.GetSolutions(iid, uid)
+	f, err := parseSolutionFilter(c)
 	if err != nil {
 		return err
 	}
+	f.TaskId = iid
+	f.AllUsers = c.QueryParam(constants.ScopeKey) == "all"
+	if f.AllUsers {
+		f.Uid, _ = strconv.ParseUint(c.QueryParam(constants.UserKey), 10, 64)
+	}
+
+	return sh.writeSolutions(c, f, uid)
+}
+
+// getUserSolutions is a feed of user's solutions to all tasks.
+func (sh SolutionHandler) getUserSolutions(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	cookie, err := c.Cookie(constants.SessionCookieName)
+	if err != nil && cookie != nil {
+		log.Println("solution handler: getUserSolutions: error getting cookie")
+		return echo.NewHTTPError(http.StatusBadRequest, "error getting cookie")
+	}
+
+	if cookie == nil {
+		log.Println("solution handler: getUserSolutions: no cookie")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
+	}
+
+	uid, err := sh.uuc.CheckSession(cookie.Value)
+	if err != nil {
+		return err
+	}
+
+	if uid == 0 {
+		log.Println("solution handler: getUserSolutions: uid 0")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
+	}
+
+	f, err := parseSolutionFilter(c)
+	if err != nil {
+		return err
+	}
+	f.TaskId, _ = strconv.ParseUint(c.QueryParam(constants.TaskKey), 10, 64)
+
+	return sh.writeSolutions(c, f, uid)
+}
+
+func (sh SolutionHandler) writeSolutions(c echo.Context, f models.SolutionFilter, uid uint64) error {
+	slns, next, err := sh.UseCase.GetSolutions(f, uid)
+	if err != nil {
+		return err
+	}
+
+	if next != 0 {
+		c.Response().Header().Set(constants.NextCursorHeader, strconv.FormatUint(next, 10))
+	}
 
 	if _, err = easyjson.MarshalToWriter(slns, c.Response().Writer); err != nil {
 		log.Println(err)
@@ -267,6 +287,48 @@ func (sh SolutionHandler) GetSolutions(c echo.Context) error {
 	return nil
 }
 
+// parseSolutionFilter reads filters of solutions history from the query:
+// verdict, language, from and to (RFC 3339), cursor, count and order.
+func parseSolutionFilter(c echo.Context) (models.SolutionFilter, error) {
+	f := models.SolutionFilter{}
+
+	if str := c.QueryParam(constants.VerdictKey); str != "" {
+		v, ok := models.ParseVerdict(str)
+		if !ok {
+			return f, echo.NewHTTPError(http.StatusBadRequest, "unknown verdict")
+		}
+		f.UseVerdict = true
+		f.Verdict = v
+	}
+
+	f.Language = c.QueryParam(constants.LanguageKey)
+
+	var err error
+	if str := c.QueryParam(constants.FromKey); str != "" {
+		if f.From, err = time.Parse(time.RFC3339, str); err != nil {
+			return f, echo.NewHTTPError(http.StatusBadRequest, "wrong from date: "+err.Error())
+		}
+	}
+	if str := c.QueryParam(constants.ToKey); str != "" {
+		if f.To, err = time.Parse(time.RFC3339, str); err != nil {
+			return f, echo.NewHTTPError(http.StatusBadRequest, "wrong to date: "+err.Error())
+		}
+	}
+
+	f.Cursor, _ = strconv.ParseUint(c.QueryParam(constants.CursorKey), 10, 64)
+	f.Count, _ = strconv.Atoi(c.QueryParam(constants.CountKey))
+
+	switch c.QueryParam(constants.OrderKey) {
+	case "", "desc":
+	case "asc":
+		f.Ascending = true
+	default:
+		return f, echo.NewHTTPError(http.StatusBadRequest, "order is either asc or desc")
+	}
+
+	return f, nil
+}
+
 func (sh SolutionHandler) getSolution(c echo.Context) error {
 	defer c.Request().Body.Close()
 	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
@@ -308,6 +370,48 @@ func (sh SolutionHandler) getSolution(c echo.Context) error {
 	return nil
 }
 
+func (sh SolutionHandler) diffSolutions(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	cookie, err := c.Cookie(constants.SessionCookieName)
+	if err != nil && cookie != nil {
+		log.Println("solution handler: diffSolutions: error getting cookie")
+		return echo.NewHTTPError(http.StatusBadRequest, "error getting cookie")
+	}
+
+	if cookie == nil {
+		log.Println("solution handler: diffSolutions: no cookie")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
+	}
+
+	uid, err := sh.uuc.CheckSession(cookie.Value)
+	if err != nil {
+		return err
+	}
+
+	if uid == 0 {
+		log.Println("solution handler: diffSolutions: uid 0")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
+	}
+
+	taskId, _ := strconv.ParseUint(string(c.Param(constants.TaskId)), 10, 64)
+	from, _ := strconv.ParseUint(string(c.Param(constants.SolutionId)), 10, 64)
+	to, _ := strconv.ParseUint(string(c.Param(constants.OtherSolutionId)), 10, 64)
+
+	d, err := sh.UseCase.DiffSolutions(taskId, from, to, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(d, c.Response().Writer); err != nil {
+		log.Println("solution handler: diffSolutions: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
 func (sh SolutionHandler) deleteSolution(c echo.Context) error {
 	defer c.Request().Body.Close()
 	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
@@ -343,3 +447,75 @@ func (sh SolutionHandler) deleteSolution(c echo.Context) error {
 
 	return nil
 }
+
+func (sh SolutionHandler) rejudgeTask(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	cookie, err := c.Cookie(constants.SessionCookieName)
+	if err != nil && cookie != nil {
+		log.Println("solution handler: rejudgeTask: error getting cookie")
+		return echo.NewHTTPError(http.StatusBadRequest, "error getting cookie")
+	}
+
+	if cookie == nil {
+		log.Println("solution handler: rejudgeTask: no cookie")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
+	}
+
+	uid, err := sh.uuc.CheckSession(cookie.Value)
+	if err != nil {
+		return err
+	}
+
+	if uid == 0 {
+		log.Println("solution handler: rejudgeTask: uid 0")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
+	}
+
+	id := c.Param(constants.IdKey)
+	iid, _ := strconv.ParseUint(string(id), 10, 64)
+
+	return sh.UseCase.RejudgeTask(iid, uid)
+}
+
+func (sh SolutionHandler) getRejudgeStatus(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	cookie, err := c.Cookie(constants.SessionCookieName)
+	if err != nil && cookie != nil {
+		log.Println("solution handler: getRejudgeStatus: error getting cookie")
+		return echo.NewHTTPError(http.StatusBadRequest, "error getting cookie")
+	}
+
+	if cookie == nil {
+		log.Println("solution handler: getRejudgeStatus: no cookie")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
+	}
+
+	uid, err := sh.uuc.CheckSession(cookie.Value)
+	if err != nil {
+		return err
+	}
+
+	if uid == 0 {
+		log.Println("solution handler: getRejudgeStatus: uid 0")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
+	}
+
+	id := c.Param(constants.IdKey)
+	iid, _ := strconv.ParseUint(string(id), 10, 64)
+
+	status, err := sh.UseCase.GetRejudgeStatus(iid, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(status, c.Response().Writer); err != nil {
+		log.Println("solution handler: getRejudgeStatus: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package http
+
+import (
+	"liokoredu/pkg/constants"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo"
+	"github.com/mailru/easyjson"
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// subscribeSolutions streams status transitions of user's solutions.
+// Optional taskId query parameter limits the stream to a single task.
+func (sh SolutionHandler) subscribeSolutions(c echo.Context) error {
+	cookie, err := c.Cookie(constants.SessionCookieName)
+	if err != nil && cookie != nil {
+		log.Println("solution handler: subscribeSolutions: error getting cookie")
+		return echo.NewHTTPError(http.StatusBadRequest, "error getting cookie")
+	}
+
+	if cookie == nil {
+		log.Println("solution handler: subscribeSolutions: no cookie")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
+	}
+
+	uid, err := sh.uuc.CheckSession(cookie.Value)
+	if err != nil {
+		return err
+	}
+
+	if uid == 0 {
+		log.Println("solution handler: subscribeSolutions: uid 0")
+		return echo.NewHTTPError(http.StatusUnauthorized, "Not authenticated")
+	}
+
+	taskId, _ := strconv.ParseUint(c.QueryParam(constants.TaskId), 10, 64)
+
+	events, cancel, err := sh.UseCase.SubscribeSolutions(uid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer cancel()
+
+	ws, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		log.Println("solution handler: subscribeSolutions: error serving ws:", err)
+		return nil
+	}
+	defer ws.Close()
+
+	// the client is not expected to send anything, reading only detects
+	// the connection close and handles pongs
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		ws.SetReadDeadline(time.Now().Add(constants.PongWait))
+		ws.SetPongHandler(func(string) error { ws.SetReadDeadline(time.Now().Add(constants.PongWait)); return nil })
+		for {
+			if _, _, err := ws.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(constants.PingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return nil
+		case <-ticker.C:
+			ws.SetWriteDeadline(time.Now().Add(constants.WriteWait))
+			if err := ws.WriteMessage(websocket.PingMessage, []byte{}); err != nil {
+				return nil
+			}
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if taskId != 0 && event.TaskId != taskId {
+				continue
+			}
+
+			payload, err := easyjson.Marshal(event)
+			if err != nil {
+				log.Println("solution handler: subscribeSolutions: error marshaling event:", err)
+				continue
+			}
+			ws.SetWriteDeadline(time.Now().Add(constants.WriteWait))
+			if err := ws.WriteMessage(websocket.TextMessage, payload); err != nil {
+				return nil
+			}
+		}
+	}
+}
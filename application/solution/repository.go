@@ -6,9 +6,34 @@ import (
 )
 
 type Repository interface {
-	InsertSolution(taskId uint64, uid uint64, code map[string]interface{}, testsTotal int, receivedTime time.Time) (uint64, error)
+	InsertSolution(taskId uint64, uid uint64, lang string, code map[string]interface{}, testsTotal int,
+		receivedTime time.Time) (uint64, error)
 	UpdateSolution(id uint64, upd *models.SolutionUpdate) error
+	ResetSolution(id uint64) (bool, error)
+	UpdateBestScore(taskId uint64, uid uint64) error
 	DeleteSolution(id uint64, uid uint64) error
-	GetSolutions(taskId uint64, uid uint64) (models.SolutionsSQL, error)
+	GetSolutions(f models.SolutionFilter) (models.SolutionsSQL, error)
 	GetSolution(id uint64, taskId uint64, uid uint64) (models.SolutionSQL, error)
+	GetSolutionTests(id uint64) (models.SolutionTestsSQL, error)
+	GetSolutionOwner(id uint64) (taskId uint64, uid uint64, err error)
+	MarkTaskSolutionsStale(taskId uint64) error
+	ClaimStaleSolutions(limit int) ([]models.JudgeJob, error)
+	GetUserTaskState(taskId uint64, uid uint64) (accepted bool, pending bool, err error)
+	CountPendingSolutions(taskId uint64) (int, error)
+	StartRejudge(taskId uint64, n int) error
+	FinishRejudgeJob(taskId uint64) error
+	GetRejudgeProgress(taskId uint64) (models.RejudgeStatus, error)
+	CountRun(uid uint64) (int, error)
+	CreateReviewThread(th *models.ReviewThreadSQL, text string) (uint64, error)
+	AddReviewComment(threadId uint64, uid uint64, text string, created time.Time) (uint64, error)
+	GetReviewThread(threadId uint64) (models.ReviewThreadSQL, error)
+	SetReviewResolved(threadId uint64, resolved bool) error
+	GetReviews(solId uint64) (models.ReviewThreadsSQL, models.ReviewCommentsSQL, error)
+	StoreNonce(id uint64, nonce string) error
+	GetNonce(id uint64) (string, error)
+	DeleteNonce(id uint64) error
+	UseSignature(signature string) (bool, error)
+	InsertCallbackAudit(audit *models.CallbackAudit) error
+	PublishEvent(uid uint64, event *models.SolutionEvent) error
+	SubscribeEvents(uid uint64) (<-chan models.SolutionEvent, func(), error)
 }
@@ -4,33 +4,47 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"io/ioutil"
+	"liokoredu/application/blobstore"
 	"liokoredu/application/models"
 	"liokoredu/application/solution"
 	"liokoredu/pkg/constants"
-	"liokoredu/pkg/generators"
 	"log"
 	"net/http"
-	"os"
-	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/georgysavva/scany/pgxscan"
+	"github.com/gomodule/redigo/redis"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/labstack/echo"
+	"github.com/mailru/easyjson"
 )
 
 type SolutionDatabase struct {
-	pool *pgxpool.Pool
+	poolRedis *redis.Pool
+	pool      *pgxpool.Pool
+	blobs     blobstore.Store
+}
+
+// loadSourceCode replaces the blob key of the solution with its content.
+func (sd *SolutionDatabase) loadSourceCode(sln *models.SolutionSQL) error {
+	code, err := sd.blobs.Get(sln.SourceCode)
+	if err != nil {
+		log.Println("solution repo: loadSourceCode: error reading source code of solution", sln.Id, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "source code of solution is lost")
+	}
+	sln.SourceCode = string(code)
+
+	return nil
 }
 
 func (sd *SolutionDatabase) GetSolution(id uint64, taskId uint64, uid uint64) (models.SolutionSQL, error) {
 	var sln models.SolutionsSQL
 	err := pgxscan.Select(context.Background(), sd.pool, &sln,
 		`SELECT * FROM solutions WHERE id = $1 AND task_id = $2 AND uid = $3`, id, taskId, uid)
-	if errors.As(err, &pgx.ErrNoRows) && len(sln) == 0 {
+	if errors.Is(err, pgx.ErrNoRows) && len(sln) == 0 {
 		log.Println("solution repo: GetSolution: error getting solution: no solution")
 		return models.SolutionSQL{}, echo.NewHTTPError(http.StatusNotFound, "solution for task from user not found")
 	}
@@ -44,72 +58,136 @@ func (sd *SolutionDatabase) GetSolution(id uint64, taskId uint64, uid uint64) (m
 		return models.SolutionSQL{}, echo.NewHTTPError(http.StatusNotFound, "solution for task from user not found")
 	}
 
-	base, _ := os.Getwd()
-	sourceCode, _ := ioutil.ReadFile(filepath.ToSlash(base + constants.SolutionsDir + sln[0].SourceCode))
-	sln[0].SourceCode = string(sourceCode)
+	if err = sd.loadSourceCode(&sln[0]); err != nil {
+		return models.SolutionSQL{}, err
+	}
 
 	return sln[0], nil
 }
 
+// DeleteSolution implements solution.Repository
+// The source code blob is removed when no other solution refers to it. The
+// blob is locked for the whole session, so it is deleted only after the
+// commit and no new solution can refer to it meanwhile.
 func (sd *SolutionDatabase) DeleteSolution(id uint64, uid uint64) error {
-	var filename []string
-
-	err := pgxscan.Select(context.Background(), sd.pool, &filename,
+	var key string
+	err := sd.pool.QueryRow(context.Background(),
 		`SELECT source_code from solutions WHERE id = $1 AND uid = $2`,
-		id, uid)
+		id, uid).Scan(&key)
+	if errors.Is(err, pgx.ErrNoRows) {
+		log.Println("solution repo: DeleteSolution: error deleting solution: no solution to delete")
+		return echo.NewHTTPError(http.StatusNotFound, "solution with solutionId from this user not found")
+	}
+	if err != nil {
+		log.Println("solution repo: DeleteSolution: error getting source code key:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
 
+	conn, err := sd.pool.Acquire(context.Background())
 	if err != nil {
-		log.Println("solution repo: DeleteSolution: error getting filename:", err)
+		log.Println("solution repo: DeleteSolution: error acquiring connection:", err)
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
+	defer conn.Release()
 
-	if len(filename) == 0 {
-		log.Println("solution repo: DeleteSolution: error deleting solution: no file")
-		return echo.NewHTTPError(http.StatusNotFound, "solution with solutionId from this user not found")
+	if _, err = conn.Exec(context.Background(), `SELECT pg_advisory_lock(hashtext($1))`, key); err != nil {
+		log.Println("solution repo: DeleteSolution: error locking blob:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer func() {
+		_, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, key)
+		if err != nil {
+			// the lock must not stay with the connection in the pool
+			log.Println("solution repo: DeleteSolution: error unlocking blob:", err)
+			_ = conn.Conn().Close(context.Background())
+		}
+	}()
+
+	unused, err := sd.deleteSolutionRow(conn, id, uid, key)
+	if err != nil {
+		return err
+	}
+
+	if unused {
+		if err = sd.blobs.Delete(key); err != nil {
+			// the solution is gone anyway, the blob is just left behind
+			log.Println("solution repo: DeleteSolution: error deleting blob", key, ":", err)
+		}
 	}
 
-	resp, err := sd.pool.Exec(context.Background(),
+	return nil
+}
+
+// deleteSolutionRow deletes the solution and tells whether its source code
+// blob is not referred to anymore.
+func (sd *SolutionDatabase) deleteSolutionRow(conn *pgxpool.Conn, id uint64, uid uint64, key string) (bool, error) {
+	tx, err := conn.Begin(context.Background())
+	if err != nil {
+		log.Println("solution repo: DeleteSolution: error starting transaction:", err)
+		return false, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer tx.Rollback(context.Background())
+
+	resp, err := tx.Exec(context.Background(),
 		`DELETE from solutions WHERE id = $1 AND uid = $2`,
 		id, uid)
-
 	if err != nil {
 		log.Println("solution repo: DeleteSolution: error deleting solution:", err)
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return false, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
-
 	if resp.RowsAffected() == 0 {
 		log.Println("solution repo: DeleteSolution: error deleting solution: no solution to delete")
-		return echo.NewHTTPError(http.StatusNotFound, "solution with solutionId from this user not found")
+		return false, echo.NewHTTPError(http.StatusNotFound, "solution with solutionId from this user not found")
 	}
 
-	base, _ := os.Getwd()
-	_ = os.Remove(filepath.ToSlash(base + constants.SolutionsDir + filename[0]))
+	var refs int
+	err = tx.QueryRow(context.Background(),
+		`SELECT count(*) FROM solutions WHERE source_code = $1`, key).Scan(&refs)
+	if err != nil {
+		log.Println("solution repo: DeleteSolution: error counting blob references:", err)
+		return false, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
 
-	return nil
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("solution repo: DeleteSolution: error committing:", err)
+		return false, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return refs == 0, nil
+}
+
+// lockBlob serializes transactions which add references to a blob with
+// deletions of solutions (see DeleteSolution), so a blob is never deleted
+// under a new solution.
+func lockBlob(tx pgx.Tx, key string) error {
+	_, err := tx.Exec(context.Background(), `SELECT pg_advisory_xact_lock(hashtext($1))`, key)
+	return err
 }
 
 // GetSolutions implements solution.Repository
-func (sd *SolutionDatabase) GetSolutions(taskId uint64, uid uint64) (models.SolutionsSQL, error) {
+// Source codes are not loaded, the history shows metadata only.
+func (sd *SolutionDatabase) GetSolutions(f models.SolutionFilter) (models.SolutionsSQL, error) {
+	order := "DESC"
+	if f.Ascending {
+		order = "ASC"
+	}
+
 	var sln models.SolutionsSQL
 	err := pgxscan.Select(context.Background(), sd.pool, &sln,
-		`SELECT * FROM solutions WHERE task_id = $1 AND uid = $2 order by id desc LIMIT 10;`, taskId, uid)
-
+		`SELECT s.*, u.username FROM solutions s JOIN users u ON u.id = s.uid
+		WHERE ($1 = 0 OR s.task_id = $1) AND ($2 = 0 OR s.uid = $2)
+		AND (NOT $3 OR s.check_result = $4) AND ($5 = '' OR s.language = $5)
+		AND (NOT $6 OR s.received_date_time >= $7) AND (NOT $8 OR s.received_date_time < $9)
+		AND ($10 = 0 OR (CASE WHEN $11 THEN s.id > $10 ELSE s.id < $10 END))
+		ORDER BY s.id `+order+` LIMIT $12`,
+		f.TaskId, f.Uid, f.UseVerdict, f.Verdict, f.Language,
+		!f.From.IsZero(), f.From, !f.To.IsZero(), f.To,
+		f.Cursor, f.Ascending, f.Count)
 	if err != nil {
 		log.Println("solution repo: GetSolutions: error getting solutions:", err)
 		return models.SolutionsSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	if len(sln) == 0 {
-		log.Println("solution repo: GetSolutions: no solutions")
-		return models.SolutionsSQL{}, nil
-	}
-
-	base, _ := os.Getwd()
-	for _, elem := range sln {
-		sourceCode, _ := ioutil.ReadFile(filepath.ToSlash(base + constants.SolutionsDir + elem.SourceCode))
-		elem.SourceCode = string(sourceCode)
-	}
-
 	return sln, nil
 }
 
@@ -117,55 +195,501 @@ func (sd *SolutionDatabase) UpdateSolution(id uint64, upd *models.SolutionUpdate
 	// PostgreSQL unable to store \x00 in string field
 	upd.CheckMessage = strings.Replace(upd.CheckMessage, "\x00", "", -1)
 
-	_, err := sd.pool.Exec(context.Background(),
-		`UPDATE solutions SET check_result = $1, tests_passed = $2, check_message = $3,
-		check_time = $4, compile_time = $5, checked_date_time = $6 WHERE id = $7`,
-		upd.Code, upd.Passed, upd.CheckMessage, upd.CheckTime, upd.CompileTime, upd.CheckedDateTime, id)
+	tx, err := sd.pool.Begin(context.Background())
+	if err != nil {
+		log.Println("solution repo: UpdateSolution: error starting transaction:", err)
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	// a verdict based on tests changed meanwhile is stored as stale, so the
+	// solution is judged again
+	_, err = tx.Exec(context.Background(),
+		`UPDATE solutions SET check_result = CASE WHEN rejudge AND $1 NOT IN ($11, $12, $13, $14) THEN $14 ELSE $1 END,
+		rejudge = false, tests_passed = $2, check_message = $3,
+		check_time = $4, compile_time = $5, checked_date_time = $6,
+		tests_total = COALESCE(NULLIF($7, 0), tests_total), score = $8,
+		task_revision = COALESCE(NULLIF($9, 0), task_revision) WHERE id = $10`,
+		upd.Code, upd.Passed, upd.CheckMessage, upd.CheckTime, upd.CompileTime, upd.CheckedDateTime,
+		upd.TestsTotal, upd.Score, upd.TaskRevision, id,
+		models.VerdictPending, models.VerdictCompiling, models.VerdictRunning, models.VerdictStale)
 
 	if err != nil {
 		log.Println(err)
 		return err
 	}
 
+	_, err = tx.Exec(context.Background(),
+		`DELETE FROM solution_tests WHERE solution_id = $1`, id)
+	if err != nil {
+		log.Println("solution repo: UpdateSolution: error deleting old test results:", err)
+		return err
+	}
+
+	for i, run := range upd.Tests {
+		_, err = tx.Exec(context.Background(),
+			`INSERT INTO solution_tests (solution_id, test_num, passed, verdict, stdout, stderr, run_time, memory)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			id, i, run.Passed, run.Verdict, cutOutput(run.Stdout, constants.TestStdoutLength),
+			cutOutput(run.Stderr, constants.TestStderrLength), run.RunTime, run.Memory)
+		if err != nil {
+			log.Println("solution repo: UpdateSolution: error inserting test result:", err)
+			return err
+		}
+	}
+
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("solution repo: UpdateSolution: error committing:", err)
+		return err
+	}
+
 	return nil
 }
 
-func (sd *SolutionDatabase) InsertSolution(taskId uint64, uid uint64, code map[string]interface{},
-	testsTotal int, receivedTime time.Time) (uint64, error) {
-	var id uint64
+// ResetSolution switches a judged solution back to pending and drops its
+// test results. Solutions without a final verdict are left as they are, so
+// they are never queued twice; false is returned for them.
+func (sd *SolutionDatabase) ResetSolution(id uint64) (bool, error) {
+	tx, err := sd.pool.Begin(context.Background())
+	if err != nil {
+		log.Println("solution repo: ResetSolution: error starting transaction:", err)
+		return false, err
+	}
+	defer tx.Rollback(context.Background())
 
-	filename := time.Now().Format("2006-01-02T15-04-05") +
-		generators.RandStringRunes(constants.PrivateLength)
+	tag, err := tx.Exec(context.Background(),
+		`UPDATE solutions SET check_result = $2, rejudge = false, tests_passed = 0, check_message = '',
+		check_time = 0, compile_time = 0, score = 0 WHERE id = $1 AND check_result NOT IN ($2, $3, $4, $5)`,
+		id, models.VerdictPending, models.VerdictCompiling, models.VerdictRunning, models.VerdictStale)
+	if err != nil {
+		log.Println("solution repo: ResetSolution: error resetting solution:", err)
+		return false, err
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
 
-	b, _ := json.Marshal(code)
-	data := []byte(b)
+	_, err = tx.Exec(context.Background(), `DELETE FROM solution_tests WHERE solution_id = $1`, id)
+	if err != nil {
+		log.Println("solution repo: ResetSolution: error deleting test results:", err)
+		return false, err
+	}
 
-	base, _ := os.Getwd()
-	file, err := os.Create(filepath.ToSlash(base + constants.SolutionsDir + filename))
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("solution repo: ResetSolution: error committing:", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// cutOutput makes program output storable: drops \x00 and invalid UTF-8
+// and keeps at most n bytes.
+func cutOutput(s string, n int) string {
+	if len(s) > n {
+		s = s[:n]
+	}
+	s = strings.Replace(s, "\x00", "", -1)
+	return strings.ToValidUTF8(s, "")
+}
+
+func (sd *SolutionDatabase) GetSolutionTests(id uint64) (models.SolutionTestsSQL, error) {
+	var tests models.SolutionTestsSQL
+	err := pgxscan.Select(context.Background(), sd.pool, &tests,
+		`SELECT * FROM solution_tests WHERE solution_id = $1 ORDER BY test_num`, id)
 	if err != nil {
-		log.Println(err)
+		log.Println("solution repo: GetSolutionTests: error getting test results:", err)
+		return models.SolutionTestsSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
-	defer file.Close()
 
-	_, err = file.Write(data)
+	return tests, nil
+}
+
+func (sd *SolutionDatabase) InsertSolution(taskId uint64, uid uint64, lang string, code map[string]interface{},
+	testsTotal int, receivedTime time.Time) (uint64, error) {
+	var id uint64
+
+	data, err := json.Marshal(code)
 	if err != nil {
-		log.Println("solution repo: InsertSolution: error creating file:", err)
+		log.Println("solution repo: InsertSolution: error marshaling source code:", err)
 		return 0, echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
+	// identical source codes share a blob
+	key := blobstore.Key(data)
 
-	err = sd.pool.QueryRow(context.Background(),
+	tx, err := sd.pool.Begin(context.Background())
+	if err != nil {
+		log.Println("solution repo: InsertSolution: error starting transaction:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer tx.Rollback(context.Background())
+
+	if err = lockBlob(tx, key); err != nil {
+		log.Println("solution repo: InsertSolution: error locking blob:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	err = tx.QueryRow(context.Background(),
 		`INSERT INTO solutions (task_id, check_result, tests_passed, tests_total, 
-			received_date_time, source_code, uid) 
-		VALUES ($1, 1, 0, $2, $3, $4, $5) RETURNING id`,
-		taskId, testsTotal, receivedTime, filename, uid).Scan(&id)
+			received_date_time, source_code, uid, language) 
+		VALUES ($1, $2, 0, $3, $4, $5, $6, $7) RETURNING id`,
+		taskId, models.VerdictPending, testsTotal, receivedTime, key, uid, lang).Scan(&id)
 	if err != nil {
 		log.Println("solution repo: InsertSolution: error inserting solution", err)
 		return 0, echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
+	exists, err := sd.blobs.Exists(key)
+	if err == nil && !exists {
+		err = sd.blobs.Put(key, data)
+	}
+	if err != nil {
+		log.Println("solution repo: InsertSolution: error saving source code:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, "unable to save source code")
+	}
+
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("solution repo: InsertSolution: error committing:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
 	return id, nil
 }
 
-func NewSolutionDatabase(conn *pgxpool.Pool) solution.Repository {
-	return &SolutionDatabase{pool: conn}
+func (sd *SolutionDatabase) GetSolutionOwner(id uint64) (uint64, uint64, error) {
+	var taskId, uid uint64
+	err := sd.pool.QueryRow(context.Background(),
+		`SELECT task_id, uid FROM solutions WHERE id = $1`, id).Scan(&taskId, &uid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, 0, echo.NewHTTPError(http.StatusNotFound, "solution not found")
+	}
+	if err != nil {
+		log.Println("solution repo: GetSolutionOwner: error getting solution:", err)
+		return 0, 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return taskId, uid, nil
+}
+
+func eventsChannel(uid uint64) string {
+	return "solutions:events:" + strconv.FormatUint(uid, 10)
+}
+
+func (sd *SolutionDatabase) PublishEvent(uid uint64, event *models.SolutionEvent) error {
+	client := sd.poolRedis.Get()
+	defer client.Close()
+
+	payload, err := easyjson.Marshal(event)
+	if err != nil {
+		log.Println("solution repo: PublishEvent: error marshaling event:", err)
+		return err
+	}
+
+	_, err = client.Do("PUBLISH", eventsChannel(uid), payload)
+	if err != nil {
+		log.Println("solution repo: PublishEvent: error publishing event:", err)
+		return err
+	}
+
+	return nil
+}
+
+// SubscribeEvents streams events of user's solutions until the returned
+// cancel function is called.
+func (sd *SolutionDatabase) SubscribeEvents(uid uint64) (<-chan models.SolutionEvent, func(), error) {
+	psc := redis.PubSubConn{Conn: sd.poolRedis.Get()}
+	if err := psc.Subscribe(eventsChannel(uid)); err != nil {
+		log.Println("solution repo: SubscribeEvents: error subscribing:", err)
+		psc.Close()
+		return nil, nil, err
+	}
+
+	events := make(chan models.SolutionEvent)
+	go func() {
+		defer close(events)
+		defer psc.Close()
+
+		for {
+			switch msg := psc.Receive().(type) {
+			case redis.Message:
+				event := models.SolutionEvent{}
+				if err := easyjson.Unmarshal(msg.Data, &event); err != nil {
+					log.Println("solution repo: SubscribeEvents: broken event:", err)
+					continue
+				}
+				events <- event
+			case redis.Subscription:
+				if msg.Count == 0 {
+					return
+				}
+			case error:
+				return
+			}
+		}
+	}()
+
+	cancel := func() {
+		_ = psc.Unsubscribe()
+		// drain events so that the receiving goroutine can exit
+		go func() {
+			for range events {
+			}
+		}()
+	}
+
+	return events, cancel, nil
+}
+
+func runsKey(uid uint64) string {
+	return "solutions:runs:" + strconv.FormatUint(uid, 10)
+}
+
+// CountRun implements solution.Repository
+// It returns the number of runs of the user in the current rate window,
+// this one included.
+func (sd *SolutionDatabase) CountRun(uid uint64) (int, error) {
+	client := sd.poolRedis.Get()
+	defer client.Close()
+
+	n, err := redis.Int(client.Do("INCR", runsKey(uid)))
+	if err != nil {
+		log.Println("solution repo: CountRun: error counting run:", err)
+		return 0, err
+	}
+	if n == 1 {
+		_, err = client.Do("EXPIRE", runsKey(uid), int(constants.RunRateWindow.Seconds()))
+		if err != nil {
+			log.Println("solution repo: CountRun: error setting window:", err)
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func nonceKey(id uint64) string {
+	return "solutions:nonce:" + strconv.FormatUint(id, 10)
+}
+
+func (sd *SolutionDatabase) StoreNonce(id uint64, nonce string) error {
+	client := sd.poolRedis.Get()
+	defer client.Close()
+
+	_, err := client.Do("SET", nonceKey(id), nonce, "EX", int(constants.CheckerNonceTTL.Seconds()))
+	if err != nil {
+		log.Println("solution repo: StoreNonce: error storing nonce:", err)
+		return err
+	}
+
+	return nil
+}
+
+func (sd *SolutionDatabase) GetNonce(id uint64) (string, error) {
+	client := sd.poolRedis.Get()
+	defer client.Close()
+
+	nonce, err := redis.String(client.Do("GET", nonceKey(id)))
+	if err == redis.ErrNil {
+		return "", nil
+	}
+	if err != nil {
+		log.Println("solution repo: GetNonce: error getting nonce:", err)
+		return "", err
+	}
+
+	return nonce, nil
+}
+
+func (sd *SolutionDatabase) DeleteNonce(id uint64) error {
+	client := sd.poolRedis.Get()
+	defer client.Close()
+
+	_, err := client.Do("DEL", nonceKey(id))
+	if err != nil {
+		log.Println("solution repo: DeleteNonce: error deleting nonce:", err)
+		return err
+	}
+
+	return nil
+}
+
+// UseSignature remembers the signature of an accepted callback and reports
+// false if it has been seen already.
+func (sd *SolutionDatabase) UseSignature(signature string) (bool, error) {
+	client := sd.poolRedis.Get()
+	defer client.Close()
+
+	// signatures older than the window are rejected by timestamp anyway
+	ttl := int(2 * constants.CheckerCallbackWindow.Seconds())
+	_, err := redis.String(client.Do("SET", "solutions:signature:"+signature, 1, "EX", ttl, "NX"))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	if err != nil {
+		log.Println("solution repo: UseSignature: error storing signature:", err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (sd *SolutionDatabase) InsertCallbackAudit(audit *models.CallbackAudit) error {
+	_, err := sd.pool.Exec(context.Background(),
+		`INSERT INTO checker_callback_audit (solution_id, remote_addr, reason, signature, body, received_date_time)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		audit.SolutionId, audit.RemoteAddr, audit.Reason, audit.Signature,
+		cutOutput(audit.Body, constants.TestStderrLength), audit.ReceivedDateTime)
+	if err != nil {
+		log.Println("solution repo: InsertCallbackAudit: error inserting audit record:", err)
+		return err
+	}
+
+	return nil
+}
+
+// MarkTaskSolutionsStale marks checked solutions of the task for rejudging.
+// Solutions waiting for a verdict are only flagged, otherwise they would be
+// judged twice at once; they become stale when their verdict is saved.
+func (sd *SolutionDatabase) MarkTaskSolutionsStale(taskId uint64) error {
+	_, err := sd.pool.Exec(context.Background(),
+		`UPDATE solutions SET check_result = CASE WHEN check_result IN ($3, $4, $5) THEN check_result ELSE $1 END,
+		rejudge = check_result IN ($3, $4, $5) WHERE task_id = $2`,
+		models.VerdictStale, taskId, models.VerdictPending, models.VerdictCompiling, models.VerdictRunning)
+	if err != nil {
+		log.Println("solution repo: MarkTaskSolutionsStale: error marking solutions:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+// ClaimStaleSolutions switches up to limit stale solutions to pending and
+// returns them. Concurrent callers never get the same solution.
+func (sd *SolutionDatabase) ClaimStaleSolutions(limit int) ([]models.JudgeJob, error) {
+	var jobs []models.JudgeJob
+	err := pgxscan.Select(context.Background(), sd.pool, &jobs,
+		`UPDATE solutions SET check_result = $1 WHERE id IN (
+			SELECT id FROM solutions WHERE check_result = $2 ORDER BY id LIMIT $3 FOR UPDATE SKIP LOCKED)
+		RETURNING id AS solution_id, task_id, uid`, models.VerdictPending, models.VerdictStale, limit)
+	if err != nil {
+		log.Println("solution repo: ClaimStaleSolutions: error claiming solutions:", err)
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// GetUserTaskState tells whether the user has an accepted solution of the task
+// and whether some of user's solutions are still waiting for a verdict.
+func (sd *SolutionDatabase) GetUserTaskState(taskId uint64, uid uint64) (bool, bool, error) {
+	var accepted, pending bool
+	err := sd.pool.QueryRow(context.Background(),
+		`SELECT COALESCE(bool_or(check_result = $3), false), COALESCE(bool_or(check_result IN ($4, $5)), false)
+		FROM solutions WHERE task_id = $1 AND uid = $2`, taskId, uid,
+		models.VerdictAccepted, models.VerdictPending, models.VerdictStale).Scan(&accepted, &pending)
+	if err != nil {
+		log.Println("solution repo: GetUserTaskState: error getting state:", err)
+		return false, false, err
+	}
+
+	return accepted, pending, nil
+}
+
+// UpdateBestScore takes the best score of the user for the task from the
+// checked solutions, so it goes down as well when solutions are rejudged.
+func (sd *SolutionDatabase) UpdateBestScore(taskId uint64, uid uint64) error {
+	_, err := sd.pool.Exec(context.Background(),
+		`INSERT INTO task_scores (uid, task_id, score)
+		SELECT $2, $1, COALESCE(max(score), 0) FROM solutions
+		WHERE task_id = $1 AND uid = $2 AND check_result NOT IN ($3, $4)
+		ON CONFLICT (uid, task_id) DO UPDATE SET score = EXCLUDED.score`,
+		taskId, uid, models.VerdictPending, models.VerdictStale)
+	if err != nil {
+		log.Println("solution repo: UpdateBestScore: error updating score:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (sd *SolutionDatabase) CountPendingSolutions(taskId uint64) (int, error) {
+	var n int
+	err := sd.pool.QueryRow(context.Background(),
+		`SELECT count(*) FROM solutions WHERE task_id = $1 AND check_result IN ($2, $3)`,
+		taskId, models.VerdictPending, models.VerdictStale).Scan(&n)
+	if err != nil {
+		log.Println("solution repo: CountPendingSolutions: error counting solutions:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return n, nil
+}
+
+func rejudgeKey(taskId uint64) string {
+	return "rejudge:task:" + strconv.FormatUint(taskId, 10)
+}
+
+// StartRejudge adds n solutions to the rejudge of the task, a finished
+// rejudge is started over.
+func (sd *SolutionDatabase) StartRejudge(taskId uint64, n int) error {
+	client := sd.poolRedis.Get()
+	defer client.Close()
+
+	key := rejudgeKey(taskId)
+	vals, err := redis.Ints(client.Do("HMGET", key, "total", "done"))
+	if err != nil {
+		log.Println("solution repo: StartRejudge: error getting progress:", err)
+		return err
+	}
+	if vals[0] > 0 && vals[1] >= vals[0] {
+		if _, err = client.Do("DEL", key); err != nil {
+			log.Println("solution repo: StartRejudge: error resetting progress:", err)
+			return err
+		}
+	}
+
+	client.Send("MULTI")
+	client.Send("HINCRBY", key, "total", n)
+	client.Send("HSETNX", key, "started", time.Now().Unix())
+	client.Send("EXPIRE", key, int(constants.RejudgeProgressTTL.Seconds()))
+	if _, err = client.Do("EXEC"); err != nil {
+		log.Println("solution repo: StartRejudge: error storing progress:", err)
+		return err
+	}
+
+	return nil
+}
+
+func (sd *SolutionDatabase) FinishRejudgeJob(taskId uint64) error {
+	client := sd.poolRedis.Get()
+	defer client.Close()
+
+	if _, err := client.Do("HINCRBY", rejudgeKey(taskId), "done", 1); err != nil {
+		log.Println("solution repo: FinishRejudgeJob: error storing progress:", err)
+		return err
+	}
+
+	return nil
+}
+
+func (sd *SolutionDatabase) GetRejudgeProgress(taskId uint64) (models.RejudgeStatus, error) {
+	client := sd.poolRedis.Get()
+	defer client.Close()
+
+	vals, err := redis.Int64s(client.Do("HMGET", rejudgeKey(taskId), "total", "done", "started"))
+	if err != nil {
+		log.Println("solution repo: GetRejudgeProgress: error getting progress:", err)
+		return models.RejudgeStatus{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	status := models.RejudgeStatus{TaskId: taskId, Total: int(vals[0]), Done: int(vals[1])}
+	if vals[2] != 0 {
+		status.StartedAt = time.Unix(vals[2], 0)
+	}
+
+	return status, nil
+}
+
+func NewSolutionDatabase(poolRedis *redis.Pool, conn *pgxpool.Pool, blobs blobstore.Store) solution.Repository {
+	return &SolutionDatabase{poolRedis: poolRedis, pool: conn, blobs: blobs}
 }
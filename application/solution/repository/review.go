@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"liokoredu/application/models"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/labstack/echo"
+)
+
+// CreateReviewThread implements solution.Repository
+// The thread is stored with its first comment.
+func (sd *SolutionDatabase) CreateReviewThread(th *models.ReviewThreadSQL, text string) (uint64, error) {
+	tx, err := sd.pool.Begin(context.Background())
+	if err != nil {
+		log.Println("solution repo: CreateReviewThread: error starting transaction:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer tx.Rollback(context.Background())
+
+	var id uint64
+	err = tx.QueryRow(context.Background(),
+		`INSERT INTO review_threads (solution_id, file_name, line_start, line_end, uid, created_date_time)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		th.SolutionId, th.FileName, th.LineStart, th.LineEnd, th.Uid, th.CreatedDateTime).Scan(&id)
+	if err != nil {
+		log.Println("solution repo: CreateReviewThread: error inserting thread:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	_, err = tx.Exec(context.Background(),
+		`INSERT INTO review_comments (thread_id, uid, text, created_date_time) VALUES ($1, $2, $3, $4)`,
+		id, th.Uid, text, th.CreatedDateTime)
+	if err != nil {
+		log.Println("solution repo: CreateReviewThread: error inserting comment:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("solution repo: CreateReviewThread: error committing:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return id, nil
+}
+
+func (sd *SolutionDatabase) AddReviewComment(threadId uint64, uid uint64, text string, created time.Time) (uint64, error) {
+	var id uint64
+	err := sd.pool.QueryRow(context.Background(),
+		`INSERT INTO review_comments (thread_id, uid, text, created_date_time) VALUES ($1, $2, $3, $4) RETURNING id`,
+		threadId, uid, text, created).Scan(&id)
+	if err != nil {
+		log.Println("solution repo: AddReviewComment: error inserting comment:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return id, nil
+}
+
+func (sd *SolutionDatabase) GetReviewThread(threadId uint64) (models.ReviewThreadSQL, error) {
+	th := models.ReviewThreadSQL{}
+	err := pgxscan.Get(context.Background(), sd.pool, &th,
+		`SELECT * FROM review_threads WHERE id = $1`, threadId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.ReviewThreadSQL{}, echo.NewHTTPError(http.StatusNotFound, "review thread not found")
+	}
+	if err != nil {
+		log.Println("solution repo: GetReviewThread: error getting thread:", err)
+		return models.ReviewThreadSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return th, nil
+}
+
+func (sd *SolutionDatabase) SetReviewResolved(threadId uint64, resolved bool) error {
+	_, err := sd.pool.Exec(context.Background(),
+		`UPDATE review_threads SET resolved = $1 WHERE id = $2`, resolved, threadId)
+	if err != nil {
+		log.Println("solution repo: SetReviewResolved: error updating thread:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+// GetReviews implements solution.Repository
+// It returns threads of the solution and all of their comments.
+func (sd *SolutionDatabase) GetReviews(solId uint64) (models.ReviewThreadsSQL, models.ReviewCommentsSQL, error) {
+	threads := models.ReviewThreadsSQL{}
+	err := pgxscan.Select(context.Background(), sd.pool, &threads,
+		`SELECT * FROM review_threads WHERE solution_id = $1 ORDER BY file_name, line_start, id`, solId)
+	if err != nil {
+		log.Println("solution repo: GetReviews: error getting threads:", err)
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	comments := models.ReviewCommentsSQL{}
+	err = pgxscan.Select(context.Background(), sd.pool, &comments,
+		`SELECT c.*, u.username FROM review_comments c
+		JOIN review_threads t ON t.id = c.thread_id
+		JOIN users u ON u.id = c.uid
+		WHERE t.solution_id = $1 ORDER BY c.id`, solId)
+	if err != nil {
+		log.Println("solution repo: GetReviews: error getting comments:", err)
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return threads, comments, nil
+}
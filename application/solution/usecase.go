@@ -3,9 +3,25 @@ package solution
 import "liokoredu/application/models"
 
 type UseCase interface {
-	InsertSolution(taskId uint64, uid uint64, code map[string]interface{}, testsTotal int) (uint64, error)
+	InsertSolution(taskId uint64, uid uint64, lang string, code map[string]interface{}, testsTotal int) (uint64, error)
+	SubmitSolution(taskId uint64, uid uint64, lang string, code map[string]interface{}) (uint64, error)
+	RunSolution(taskId uint64, uid uint64, run *models.RunRequest) (*models.RunResult, error)
+	RerunSolution(solId uint64, taskId uint64, uid uint64) error
+	JudgeSolution(job models.JudgeJob) error
+	AbandonSolution(job models.JudgeJob) error
+	RejudgeTask(taskId uint64, uid uint64) error
+	RejudgeStale() error
+	GetRejudgeStatus(taskId uint64, uid uint64) (models.RejudgeStatus, error)
 	UpdateSolution(id uint64, upd models.SolutionUpdate) error
 	DeleteSolution(id uint64, uid uint64) error
-	GetSolutions(taskId uint64, uid uint64) (models.Solutions, error)
+	GetSolutions(f models.SolutionFilter, uid uint64) (models.Solutions, uint64, error)
 	GetSolution(solId uint64, taskId uint64, uid uint64) (models.SolutionFull, error)
+	DiffSolutions(taskId uint64, from uint64, to uint64, uid uint64) (models.SolutionDiff, error)
+	GetReviews(taskId uint64, solId uint64, uid uint64) (models.ReviewThreads, error)
+	CreateReviewThread(taskId uint64, solId uint64, uid uint64, th *models.ReviewThreadNew) (models.ReviewThread, error)
+	ReplyReview(taskId uint64, solId uint64, threadId uint64, uid uint64, text string) (models.ReviewComment, error)
+	ResolveReview(taskId uint64, solId uint64, threadId uint64, uid uint64, resolved bool) error
+	CheckCallback(id uint64, nonce string, signature string) error
+	AuditCallback(audit models.CallbackAudit)
+	SubscribeSolutions(uid uint64) (<-chan models.SolutionEvent, func(), error)
 }
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"encoding/json"
+	"liokoredu/application/models"
+	"liokoredu/pkg/constants"
+	"liokoredu/pkg/diff"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo"
+)
+
+// GetReviews implements solution.UseCase
+func (suc *SolutionUseCase) GetReviews(taskId uint64, solId uint64, uid uint64) (models.ReviewThreads, error) {
+	if _, _, err := suc.solutionAccess(solId, taskId, uid); err != nil {
+		return models.ReviewThreads{}, err
+	}
+
+	threads, comments, err := suc.repo.GetReviews(solId)
+	if err != nil {
+		return models.ReviewThreads{}, err
+	}
+
+	return threads.ConvertToJson(comments), nil
+}
+
+// CreateReviewThread implements solution.UseCase
+// Only authors of the task review solutions, the lines must exist in the
+// file of the solution.
+func (suc *SolutionUseCase) CreateReviewThread(taskId uint64, solId uint64, uid uint64,
+	th *models.ReviewThreadNew) (models.ReviewThread, error) {
+	owner, isAuthor, err := suc.solutionAccess(solId, taskId, uid)
+	if err != nil {
+		return models.ReviewThread{}, err
+	}
+	if !isAuthor {
+		return models.ReviewThread{}, echo.NewHTTPError(http.StatusForbidden, "only the author of the task can review solutions")
+	}
+
+	text, err := validateComment(th.Text)
+	if err != nil {
+		return models.ReviewThread{}, err
+	}
+
+	sln, err := suc.repo.GetSolution(solId, taskId, owner)
+	if err != nil {
+		return models.ReviewThread{}, err
+	}
+	var code map[string]interface{}
+	if err = json.Unmarshal([]byte(sln.SourceCode), &code); err != nil {
+		log.Println("solution usecase: CreateReviewThread: broken source code of solution", solId, err)
+		return models.ReviewThread{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	file, ok := code[th.FileName].(string)
+	if !ok {
+		return models.ReviewThread{}, echo.NewHTTPError(http.StatusBadRequest, "solution has no file "+th.FileName)
+	}
+	if th.LineStart < 1 || th.LineEnd < th.LineStart || th.LineEnd > len(diff.SplitLines(file)) {
+		return models.ReviewThread{}, echo.NewHTTPError(http.StatusBadRequest, "wrong lines")
+	}
+
+	thSQL := &models.ReviewThreadSQL{
+		SolutionId:      solId,
+		FileName:        th.FileName,
+		LineStart:       th.LineStart,
+		LineEnd:         th.LineEnd,
+		Uid:             uid,
+		CreatedDateTime: now(),
+	}
+	thSQL.Id, err = suc.repo.CreateReviewThread(thSQL, text)
+	if err != nil {
+		return models.ReviewThread{}, err
+	}
+
+	return suc.getReviewThread(solId, thSQL.Id)
+}
+
+// ReplyReview implements solution.UseCase
+// Both the owner of the solution and authors of the task take part.
+func (suc *SolutionUseCase) ReplyReview(taskId uint64, solId uint64, threadId uint64, uid uint64,
+	text string) (models.ReviewComment, error) {
+	if err := suc.reviewAccess(taskId, solId, threadId, uid); err != nil {
+		return models.ReviewComment{}, err
+	}
+
+	text, err := validateComment(text)
+	if err != nil {
+		return models.ReviewComment{}, err
+	}
+
+	id, err := suc.repo.AddReviewComment(threadId, uid, text, now())
+	if err != nil {
+		return models.ReviewComment{}, err
+	}
+
+	th, err := suc.getReviewThread(solId, threadId)
+	if err != nil {
+		return models.ReviewComment{}, err
+	}
+	for _, c := range th.Comments {
+		if c.Id == id {
+			return c, nil
+		}
+	}
+
+	return models.ReviewComment{}, echo.NewHTTPError(http.StatusInternalServerError, "comment is lost")
+}
+
+// ResolveReview implements solution.UseCase
+func (suc *SolutionUseCase) ResolveReview(taskId uint64, solId uint64, threadId uint64, uid uint64, resolved bool) error {
+	if err := suc.reviewAccess(taskId, solId, threadId, uid); err != nil {
+		return err
+	}
+
+	return suc.repo.SetReviewResolved(threadId, resolved)
+}
+
+func (suc *SolutionUseCase) reviewAccess(taskId uint64, solId uint64, threadId uint64, uid uint64) error {
+	if _, _, err := suc.solutionAccess(solId, taskId, uid); err != nil {
+		return err
+	}
+
+	th, err := suc.repo.GetReviewThread(threadId)
+	if err != nil {
+		return err
+	}
+	if th.SolutionId != solId {
+		return echo.NewHTTPError(http.StatusNotFound, "review thread not found")
+	}
+
+	return nil
+}
+
+func (suc *SolutionUseCase) getReviewThread(solId uint64, threadId uint64) (models.ReviewThread, error) {
+	threads, comments, err := suc.repo.GetReviews(solId)
+	if err != nil {
+		return models.ReviewThread{}, err
+	}
+
+	for _, th := range threads.ConvertToJson(comments) {
+		if th.Id == threadId {
+			return th, nil
+		}
+	}
+
+	return models.ReviewThread{}, echo.NewHTTPError(http.StatusNotFound, "review thread not found")
+}
+
+func validateComment(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "comment is empty")
+	}
+	if len(text) > constants.ReviewCommentLength {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "comment is too long")
+	}
+	return text, nil
+}
+
+func now() time.Time {
+	location, _ := time.LoadLocation("Europe/London")
+	return time.Now().In(location)
+}
@@ -1,29 +1,127 @@
 package usecase
 
 import (
+	"encoding/json"
+	"liokoredu/application/checker"
+	"liokoredu/application/judge"
+	"liokoredu/application/language"
 	"liokoredu/application/models"
 	"liokoredu/application/solution"
 	"liokoredu/application/task"
+	"liokoredu/pkg/constants"
+	"liokoredu/pkg/diff"
+	"liokoredu/pkg/generators"
+	"log"
+	"net/http"
 	"time"
+
+	"github.com/labstack/echo"
 )
 
 type SolutionUseCase struct {
-	repo   solution.Repository
-	ucTask task.UseCase
+	repo    solution.Repository
+	ucTask  task.UseCase
+	checker checker.Checker
+	queue   judge.Queue
 }
 
 // GetSolution implements solution.UseCase
+// Authors of the task see solutions of all users, so they can review them.
 func (suc *SolutionUseCase) GetSolution(solId uint64, taskId uint64, uid uint64) (models.SolutionFull, error) {
-	sln, err := suc.repo.GetSolution(solId, taskId, uid)
+	owner, isAuthor, err := suc.solutionAccess(solId, taskId, uid)
+	if err != nil {
+		return models.SolutionFull{}, err
+	}
+	sln, err := suc.repo.GetSolution(solId, taskId, owner)
+	if err != nil {
+		return models.SolutionFull{}, err
+	}
+	tsk, err := suc.checkedTask(taskId, uid, sln.TaskRevision)
 	if err != nil {
 		return models.SolutionFull{}, err
 	}
-	tsk, err := suc.ucTask.GetTask(taskId, uid, true)
+	runs, err := suc.repo.GetSolutionTests(solId)
+	if err != nil {
+		return models.SolutionFull{}, err
+	}
+	threads, comments, err := suc.repo.GetReviews(solId)
 	if err != nil {
 		return models.SolutionFull{}, err
 	}
 
-	return sln.ConvertToFull(tsk), nil
+	full := sln.ConvertToFull(tsk, runs, isAuthor)
+	full.Reviews = threads.ConvertToJson(comments)
+	return full, nil
+}
+
+// checkedTask loads the task with the tests the solution was checked on.
+// When the revision is unknown, no tests are shown with the results.
+func (suc *SolutionUseCase) checkedTask(taskId uint64, uid uint64, revision int) (*models.Task, error) {
+	tsk, err := suc.ucTask.GetTaskSettings(taskId, uid)
+	if err != nil {
+		return nil, err
+	}
+	if revision == tsk.Revision {
+		return suc.ucTask.GetTask(taskId, uid, true)
+	}
+
+	tsk.Tests, tsk.Subtasks = models.TaskTests{}, models.TaskSubtasks{}
+	if revision == 0 {
+		return tsk, nil
+	}
+	rev, err := suc.ucTask.GetTaskAtRevision(taskId, revision)
+	if httpErr, ok := err.(*echo.HTTPError); ok && httpErr.Code == http.StatusNotFound {
+		return tsk, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	tsk.Tests, tsk.Subtasks = rev.Tests, rev.Subtasks
+
+	return tsk, nil
+}
+
+// solutionAccess finds the owner of the solution and tells whether the user
+// manages the task. Nobody else is allowed to see the solution.
+func (suc *SolutionUseCase) solutionAccess(solId uint64, taskId uint64, uid uint64) (uint64, bool, error) {
+	solTaskId, owner, err := suc.repo.GetSolutionOwner(solId)
+	if err != nil {
+		return 0, false, err
+	}
+	isAuthor, err := suc.ucTask.CanManageTask(taskId, uid)
+	if err != nil {
+		return 0, false, err
+	}
+	if solTaskId != taskId || (owner != uid && !isAuthor) {
+		return 0, false, echo.NewHTTPError(http.StatusNotFound, "solution for task from user not found")
+	}
+
+	return owner, isAuthor, nil
+}
+
+// DiffSolutions implements solution.UseCase
+// Both solutions must belong to the user.
+func (suc *SolutionUseCase) DiffSolutions(taskId uint64, from uint64, to uint64, uid uint64) (models.SolutionDiff, error) {
+	codes := []map[string]interface{}{}
+	for _, id := range []uint64{from, to} {
+		sln, err := suc.repo.GetSolution(id, taskId, uid)
+		if err != nil {
+			return models.SolutionDiff{}, err
+		}
+
+		code := map[string]interface{}{}
+		if err = json.Unmarshal([]byte(sln.SourceCode), &code); err != nil {
+			log.Println("solution usecase: DiffSolutions: broken source code of solution", id, err)
+			return models.SolutionDiff{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		codes = append(codes, code)
+	}
+
+	return models.SolutionDiff{
+		From:  from,
+		To:    to,
+		Files: diff.Files(codes[0], codes[1]),
+	}, nil
 }
 
 // DeleteSolution implements solution.UseCase
@@ -31,30 +129,518 @@ func (suc *SolutionUseCase) DeleteSolution(id uint64, uid uint64) error {
 	return suc.repo.DeleteSolution(id, uid)
 }
 
-func (sd *SolutionUseCase) GetSolutions(taskId uint64, uid uint64) (models.Solutions, error) {
-	slnsSQL, err := sd.repo.GetSolutions(taskId, uid)
+// GetSolutions implements solution.UseCase
+// Users see their own solutions, solutions of all users are shown to the
+// author of the task only. The cursor of the next page is zero on the last one.
+func (suc *SolutionUseCase) GetSolutions(f models.SolutionFilter, uid uint64) (models.Solutions, uint64, error) {
+	if f.AllUsers {
+		if f.TaskId == 0 {
+			return models.Solutions{}, 0, echo.NewHTTPError(http.StatusBadRequest, "solutions of all users are shown for a task only")
+		}
+		allowed, err := suc.ucTask.CanManageTask(f.TaskId, uid)
+		if err != nil {
+			return models.Solutions{}, 0, err
+		}
+		if !allowed {
+			return models.Solutions{}, 0, echo.NewHTTPError(http.StatusForbidden, "only the author of the task can see all its solutions")
+		}
+	} else {
+		f.Uid = uid
+	}
+
+	if f.Count <= 0 {
+		f.Count = constants.SolutionsPerPage
+	}
+	if f.Count > constants.MaxSolutionsPerPage {
+		f.Count = constants.MaxSolutionsPerPage
+	}
+	count := f.Count
+	// one more to know whether there is a next page
+	f.Count++
+
+	slnsSQL, err := suc.repo.GetSolutions(f)
 	if err != nil {
-		return models.Solutions{}, err
+		return models.Solutions{}, 0, err
+	}
+
+	var next uint64
+	if len(slnsSQL) > count {
+		slnsSQL = slnsSQL[:count]
+		next = slnsSQL[count-1].Id
 	}
 
-	return slnsSQL.ConvertToJson(), nil
+	return slnsSQL.ConvertToJson(), next, nil
 }
 
+// UpdateSolution implements solution.UseCase
+// Checker callbacks only report intermediate statuses (compiling, running),
+// which are published to the subscribers. The final verdict is taken from
+// the checker by the judge, which applies limits and scores it, so final
+// updates sent to the callback are ignored.
 func (sd *SolutionUseCase) UpdateSolution(id uint64, upd models.SolutionUpdate) error {
+	switch upd.Status {
+	case models.SolutionStatusQueued, models.SolutionStatusCompiling, models.SolutionStatusRunning:
+	default:
+		log.Println("solution usecase: UpdateSolution: ignoring final update of solution", id, "from callback")
+		return nil
+	}
+	checker.NormalizeVerdict(&upd)
+
+	taskId, uid, err := sd.repo.GetSolutionOwner(id)
+	if err != nil {
+		return err
+	}
+	sd.publishUpdate(id, taskId, uid, upd)
+
+	return nil
+}
+
+// saveVerdict stores the final verdict of the solution and publishes it.
+func (sd *SolutionUseCase) saveVerdict(id uint64, upd models.SolutionUpdate) error {
+	upd.Status = models.SolutionStatusChecked
+	checker.NormalizeVerdict(&upd)
+
 	location, _ := time.LoadLocation("Europe/London")
+	upd.CheckedDateTime = time.Now().In(location)
+	if err := sd.repo.UpdateSolution(id, &upd); err != nil {
+		return err
+	}
+	// the job is over, no more callbacks are expected for it
+	_ = sd.repo.DeleteNonce(id)
+
+	taskId, uid, err := sd.repo.GetSolutionOwner(id)
+	if err != nil {
+		return err
+	}
+	if err = sd.repo.UpdateBestScore(taskId, uid); err != nil {
+		return err
+	}
+	sd.publishUpdate(id, taskId, uid, upd)
+
+	return nil
+}
 
-	checked := time.Now().In(location)
-	upd.CheckedDateTime = checked
-	return sd.repo.UpdateSolution(id, &upd)
+func (sd *SolutionUseCase) publishUpdate(id uint64, taskId uint64, uid uint64, upd models.SolutionUpdate) {
+	sd.publish(uid, &models.SolutionEvent{
+		Id:           id,
+		TaskId:       taskId,
+		Status:       upd.Status,
+		CurrentTest:  upd.CurrentTest,
+		CheckResult:  upd.Code,
+		CheckMessage: upd.CheckMessage,
+		TestsPassed:  upd.Passed,
+		TestsTotal:   upd.TestsTotal,
+		Score:        upd.Score,
+	})
 }
 
-func (s *SolutionUseCase) InsertSolution(taskId uint64, uid uint64, code map[string]interface{}, testsTotal int) (uint64, error) {
+// publish notifies subscribers, a failure here must not break judging.
+func (sd *SolutionUseCase) publish(uid uint64, event *models.SolutionEvent) {
+	if err := sd.repo.PublishEvent(uid, event); err != nil {
+		log.Println("solution usecase: publish: event for solution", event.Id, "is lost:", err)
+	}
+}
+
+// CheckCallback implements solution.UseCase
+// The signature itself is verified by the caller, here the nonce must match
+// the one issued for the job and the signature must not be replayed.
+func (sd *SolutionUseCase) CheckCallback(id uint64, nonce string, signature string) error {
+	expected, err := sd.repo.GetNonce(id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if expected == "" || expected != nonce {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unknown nonce")
+	}
+
+	fresh, err := sd.repo.UseSignature(signature)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if !fresh {
+		return echo.NewHTTPError(http.StatusUnauthorized, "replayed callback")
+	}
+
+	return nil
+}
+
+// AuditCallback implements solution.UseCase
+func (sd *SolutionUseCase) AuditCallback(audit models.CallbackAudit) {
+	location, _ := time.LoadLocation("Europe/London")
+	audit.ReceivedDateTime = time.Now().In(location)
+
+	log.Println("solution usecase: AuditCallback: rejected callback for solution", audit.SolutionId,
+		"from", audit.RemoteAddr, ":", audit.Reason)
+	_ = sd.repo.InsertCallbackAudit(&audit)
+}
+
+// SubscribeSolutions implements solution.UseCase
+func (sd *SolutionUseCase) SubscribeSolutions(uid uint64) (<-chan models.SolutionEvent, func(), error) {
+	return sd.repo.SubscribeEvents(uid)
+}
+
+func (s *SolutionUseCase) InsertSolution(taskId uint64, uid uint64, lang string, code map[string]interface{}, testsTotal int) (uint64, error) {
 	location, _ := time.LoadLocation("Europe/London")
 
 	received := time.Now().In(location)
-	return s.repo.InsertSolution(taskId, uid, code, testsTotal, received)
+	return s.repo.InsertSolution(taskId, uid, lang, code, testsTotal, received)
+}
+
+// SubmitSolution implements solution.UseCase
+// Solutions without a language are taken as written in the default one.
+func (s *SolutionUseCase) SubmitSolution(taskId uint64, uid uint64, lang string, code map[string]interface{}) (uint64, error) {
+	if lang == "" {
+		lang = constants.DefaultLanguage
+	}
+	l, ok := language.Get(lang)
+	if !ok {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, "unknown language "+lang)
+	}
+	if err := language.CheckFiles(l, code); err != nil {
+		return 0, err
+	}
+
+	tsk, err := s.ucTask.GetTaskSettings(taskId, uid)
+	if err != nil {
+		return 0, err
+	}
+	if !isAllowed(tsk.Languages, lang) {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, l.Name+" is not allowed for this task")
+	}
+
+	solId, err := s.InsertSolution(taskId, uid, lang, code, tsk.TestsAmount)
+	if err != nil {
+		return 0, err
+	}
+
+	return solId, s.enqueue(solId, taskId, uid, tsk.TestsAmount)
+}
+
+// RunSolution implements solution.UseCase
+// The program is run with limits of the task and is not stored anywhere.
+func (s *SolutionUseCase) RunSolution(taskId uint64, uid uint64, run *models.RunRequest) (*models.RunResult, error) {
+	lang := run.Language
+	if lang == "" {
+		lang = constants.DefaultLanguage
+	}
+	l, ok := language.Get(lang)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "unknown language "+lang)
+	}
+	if err := language.CheckFiles(l, run.SourceCode); err != nil {
+		return nil, err
+	}
+	if len(run.Stdin) > constants.RunStdinLength {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "input is too long")
+	}
+
+	tsk, err := s.ucTask.GetTaskSettings(taskId, uid)
+	if err != nil {
+		return nil, err
+	}
+	if !isAllowed(tsk.Languages, lang) {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, l.Name+" is not allowed for this task")
+	}
+
+	n, err := s.repo.CountRun(uid)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if n > constants.RunRateLimit {
+		return nil, echo.NewHTTPError(http.StatusTooManyRequests, "too many runs, try again later")
+	}
+
+	return s.checker.Run(&models.RunSend{
+		SourceCode: run.SourceCode,
+		Stdin:      run.Stdin,
+		Limits:     tsk.Limits,
+		Language:   l,
+	})
+}
+
+func isAllowed(allowed []string, lang string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, id := range allowed {
+		if id == lang {
+			return true
+		}
+	}
+	return false
+}
+
+// RerunSolution implements solution.UseCase
+// Only solutions with a final verdict are judged again, reruns count
+// against the same limit as runs on custom input. The task must be visible
+// to the user as for a new submission.
+func (s *SolutionUseCase) RerunSolution(solId uint64, taskId uint64, uid uint64) error {
+	sln, err := s.repo.GetSolution(solId, taskId, uid)
+	if err != nil {
+		return err
+	}
+	// users who lost access to a private task can't be judged on it anymore
+	if _, err = s.ucTask.GetTaskSettings(taskId, uid); err != nil {
+		return err
+	}
+	if !sln.CheckResult.Final() {
+		return echo.NewHTTPError(http.StatusConflict, "solution is being judged already")
+	}
+
+	n, err := s.repo.CountRun(uid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if n > constants.RunRateLimit {
+		return echo.NewHTTPError(http.StatusTooManyRequests, "too many runs, try again later")
+	}
+
+	reset, err := s.repo.ResetSolution(solId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if !reset {
+		return echo.NewHTTPError(http.StatusConflict, "solution is being judged already")
+	}
+
+	return s.enqueue(solId, taskId, uid, sln.TestsTotal)
+}
+
+func (s *SolutionUseCase) enqueue(solId uint64, taskId uint64, uid uint64, testsTotal int) error {
+	err := s.queue.Push(&models.JudgeJob{SolutionId: solId, TaskId: taskId, Uid: uid})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "unable to queue solution for checking")
+	}
+
+	s.publish(uid, &models.SolutionEvent{
+		Id:          solId,
+		TaskId:      taskId,
+		Status:      models.SolutionStatusQueued,
+		CheckResult: models.VerdictPending,
+		TestsTotal:  testsTotal,
+	})
+
+	return nil
+}
+
+// JudgeSolution implements solution.UseCase
+// It sends the solution to the checker, waits for the verdict, stores it
+// with the score and marks the task as done when every test is passed.
+func (s *SolutionUseCase) JudgeSolution(job models.JudgeJob) error {
+	sln, err := s.repo.GetSolution(job.SolutionId, job.TaskId, job.Uid)
+	if httpErr, ok := err.(*echo.HTTPError); ok && httpErr.Code == http.StatusNotFound {
+		log.Println("solution usecase: JudgeSolution: solution", job.SolutionId, "is gone, skipping")
+		s.finishRejudgeJob(job)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	tsk, err := s.ucTask.GetTaskToJudge(job.TaskId)
+	if err != nil {
+		return err
+	}
+
+	lang, ok := language.Get(sln.Language)
+	if !ok {
+		log.Println("solution usecase: JudgeSolution: unknown language of solution", job.SolutionId, sln.Language)
+		return echo.NewHTTPError(http.StatusInternalServerError, "unknown language "+sln.Language)
+	}
+
+	var code map[string]interface{}
+	if err = json.Unmarshal([]byte(sln.SourceCode), &code); err != nil {
+		log.Println("solution usecase: JudgeSolution: broken source code of solution", job.SolutionId, err)
+		return err
+	}
+
+	nonce := generators.RandStringRunes(constants.CheckerNonceLength)
+	if err = s.repo.StoreNonce(job.SolutionId, nonce); err != nil {
+		return err
+	}
+
+	ss := &models.SolutionSend{
+		Id:         job.SolutionId,
+		Nonce:      nonce,
+		SourceCode: code,
+		Tests:      tsk.Tests.Input(),
+		Checker:    tsk.Checker,
+		Limits:     tsk.Limits,
+		Language:   lang,
+	}
+
+	s.publish(job.Uid, &models.SolutionEvent{
+		Id:          job.SolutionId,
+		TaskId:      job.TaskId,
+		Status:      models.SolutionStatusCompiling,
+		CheckResult: models.VerdictCompiling,
+		TestsTotal:  len(tsk.Tests),
+	})
+
+	if err = s.checker.Submit(ss); err != nil {
+		return err
+	}
+
+	update, err := s.waitVerdict(ss.Id, checker.Deadline(ss))
+	if err != nil {
+		return err
+	}
+
+	update.Status = models.SolutionStatusChecked
+	checker.NormalizeVerdict(update)
+	checker.EnforceLimits(tsk.Limits, update)
+	update.Score, _ = tsk.Subtasks.Score(update.Tests, update.Code == models.VerdictAccepted)
+	update.TaskRevision = tsk.Revision
+	if err = s.saveVerdict(ss.Id, *update); err != nil {
+		return err
+	}
+	s.finishRejudgeJob(job)
+
+	if update.Code == models.VerdictAccepted {
+		return s.ucTask.MarkTaskDone(job.TaskId, job.Uid)
+	}
+	if job.Rejudge {
+		return s.settleTaskDone(job.TaskId, job.Uid)
+	}
+
+	return nil
+}
+
+// AbandonSolution implements solution.UseCase
+// It is called when the judge gave up on the solution.
+func (s *SolutionUseCase) AbandonSolution(job models.JudgeJob) error {
+	s.finishRejudgeJob(job)
+	return s.saveVerdict(job.SolutionId, models.SolutionUpdate{
+		Code:         models.VerdictJudgeError,
+		CheckMessage: "judge is unavailable: " + job.Error,
+	})
+}
+
+// settleTaskDone takes the task back from a user who has no accepted
+// solutions left after rejudging. Users with solutions still waiting for
+// a verdict are left as they are until those are checked.
+func (s *SolutionUseCase) settleTaskDone(taskId uint64, uid uint64) error {
+	accepted, pending, err := s.repo.GetUserTaskState(taskId, uid)
+	if err != nil {
+		return err
+	}
+
+	if accepted {
+		return s.ucTask.MarkTaskDone(taskId, uid)
+	}
+	if !pending {
+		return s.ucTask.UnmarkTaskDone(taskId, uid)
+	}
+
+	return nil
+}
+
+func (s *SolutionUseCase) finishRejudgeJob(job models.JudgeJob) {
+	if job.Rejudge {
+		_ = s.repo.FinishRejudgeJob(job.TaskId)
+	}
+}
+
+// RejudgeTask implements solution.UseCase
+func (s *SolutionUseCase) RejudgeTask(taskId uint64, uid uint64) error {
+	allowed, err := s.ucTask.CanManageTask(taskId, uid)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return echo.NewHTTPError(http.StatusForbidden, "only the author of the task can rejudge it")
+	}
+
+	if err = s.repo.MarkTaskSolutionsStale(taskId); err != nil {
+		return err
+	}
+
+	return s.RejudgeStale()
+}
+
+// RejudgeStale implements solution.UseCase
+// Stale solutions appear when tests of a task are changed (see the
+// update_solution trigger) or after an explicit rejudge.
+func (s *SolutionUseCase) RejudgeStale() error {
+	for {
+		jobs, err := s.repo.ClaimStaleSolutions(constants.RejudgeBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(jobs) == 0 {
+			return nil
+		}
+
+		perTask := map[uint64]int{}
+		for _, job := range jobs {
+			perTask[job.TaskId]++
+		}
+		for taskId, n := range perTask {
+			if err = s.repo.StartRejudge(taskId, n); err != nil {
+				return err
+			}
+		}
+
+		for _, job := range jobs {
+			job.Rejudge = true
+			if err = s.queue.Push(&job); err != nil {
+				return err
+			}
+			s.publish(job.Uid, &models.SolutionEvent{
+				Id:          job.SolutionId,
+				TaskId:      job.TaskId,
+				Status:      models.SolutionStatusQueued,
+				CheckResult: models.VerdictPending,
+			})
+		}
+		log.Println("solution usecase: RejudgeStale: queued", len(jobs), "solutions")
+	}
+}
+
+// GetRejudgeStatus implements solution.UseCase
+func (s *SolutionUseCase) GetRejudgeStatus(taskId uint64, uid uint64) (models.RejudgeStatus, error) {
+	allowed, err := s.ucTask.CanManageTask(taskId, uid)
+	if err != nil {
+		return models.RejudgeStatus{}, err
+	}
+	if !allowed {
+		return models.RejudgeStatus{}, echo.NewHTTPError(http.StatusForbidden, "only the author of the task can see its rejudge")
+	}
+
+	status, err := s.repo.GetRejudgeProgress(taskId)
+	if err != nil {
+		return models.RejudgeStatus{}, err
+	}
+	status.Pending, err = s.repo.CountPendingSolutions(taskId)
+	if err != nil {
+		return models.RejudgeStatus{}, err
+	}
+	status.InProgress = status.Done < status.Total
+
+	return status, nil
+}
+
+// waitVerdict polls the checker until the verdict is ready or the time the
+// checking may take is over.
+func (s *SolutionUseCase) waitVerdict(id uint64, timeout time.Duration) (*models.SolutionUpdate, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		update, done, err := s.checker.Poll(id)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return update, nil
+		}
+
+		if time.Now().After(deadline) {
+			log.Println("solution usecase: waitVerdict: checker timeout for solution", id)
+			_ = s.checker.Cancel(id)
+			return nil, echo.NewHTTPError(http.StatusGatewayTimeout, "checker did not answer in time")
+		}
+		time.Sleep(constants.CheckerPollInterval)
+	}
 }
 
-func NewSolutionUseCase(s solution.Repository, t task.UseCase) solution.UseCase {
-	return &SolutionUseCase{repo: s, ucTask: t}
+func NewSolutionUseCase(s solution.Repository, t task.UseCase, c checker.Checker, q judge.Queue) solution.UseCase {
+	return &SolutionUseCase{repo: s, ucTask: t, checker: c, queue: q}
 }
@@ -0,0 +1,537 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"liokoredu/application/checker/fake"
+	"liokoredu/application/judge"
+	"liokoredu/application/models"
+	"liokoredu/application/solution"
+	"liokoredu/application/solution/usecase"
+	"liokoredu/application/task"
+	"liokoredu/pkg/constants"
+
+	"github.com/labstack/echo"
+)
+
+type solutionRepo struct {
+	solution.Repository
+	code    map[string]interface{}
+	lang    string
+	updates map[uint64]models.SolutionUpdate
+	events  []models.SolutionEvent
+	nonces  map[uint64]string
+	seen    map[string]bool
+	filter  models.SolutionFilter
+	runs    int
+	best    int
+	// task revision the solution was checked on
+	revision int
+	verdict  models.Verdict
+
+	threads  models.ReviewThreadsSQL
+	comments models.ReviewCommentsSQL
+}
+
+func (sr *solutionRepo) CountRun(uid uint64) (int, error) {
+	sr.runs++
+	return sr.runs, nil
+}
+
+func (sr *solutionRepo) InsertSolution(taskId uint64, uid uint64, lang string, code map[string]interface{},
+	testsTotal int, receivedTime time.Time) (uint64, error) {
+	sr.code = code
+	sr.lang = lang
+	return 42, nil
+}
+
+func (sr *solutionRepo) GetSolution(id uint64, taskId uint64, uid uint64) (models.SolutionSQL, error) {
+	code, _ := json.Marshal(sr.code)
+	return models.SolutionSQL{Id: id, TaskId: taskId, Uid: uid, SourceCode: string(code), Language: sr.lang,
+		TaskRevision: sr.revision, CheckResult: sr.verdict}, nil
+}
+
+func (sr *solutionRepo) ResetSolution(id uint64) (bool, error) {
+	if !sr.verdict.Final() {
+		return false, nil
+	}
+	sr.verdict = models.VerdictPending
+	return true, nil
+}
+
+func (sr *solutionRepo) GetSolutionTests(id uint64) (models.SolutionTestsSQL, error) {
+	return models.SolutionTestsSQL{{TestNum: 0, Stdout: "3", Passed: true}}, nil
+}
+
+func (sr *solutionRepo) UpdateSolution(id uint64, upd *models.SolutionUpdate) error {
+	sr.updates[id] = *upd
+	return nil
+}
+
+func (sr *solutionRepo) UpdateBestScore(taskId uint64, uid uint64) error {
+	sr.best = 0
+	for _, upd := range sr.updates {
+		if upd.Score > sr.best {
+			sr.best = upd.Score
+		}
+	}
+	return nil
+}
+
+func (sr *solutionRepo) CreateReviewThread(th *models.ReviewThreadSQL, text string) (uint64, error) {
+	th.Id = uint64(len(sr.threads) + 1)
+	sr.threads = append(sr.threads, *th)
+	sr.comments = append(sr.comments, models.ReviewCommentSQL{Id: 1, ThreadId: th.Id, Uid: th.Uid, Text: text})
+	return th.Id, nil
+}
+
+func (sr *solutionRepo) GetReviews(solId uint64) (models.ReviewThreadsSQL, models.ReviewCommentsSQL, error) {
+	return sr.threads, sr.comments, nil
+}
+
+func (sr *solutionRepo) GetSolutionOwner(id uint64) (uint64, uint64, error) {
+	return 7, 1, nil
+}
+
+func (sr *solutionRepo) StoreNonce(id uint64, nonce string) error {
+	sr.nonces[id] = nonce
+	return nil
+}
+
+func (sr *solutionRepo) GetNonce(id uint64) (string, error) {
+	return sr.nonces[id], nil
+}
+
+func (sr *solutionRepo) DeleteNonce(id uint64) error {
+	delete(sr.nonces, id)
+	return nil
+}
+
+func (sr *solutionRepo) UseSignature(signature string) (bool, error) {
+	if sr.seen[signature] {
+		return false, nil
+	}
+	sr.seen[signature] = true
+	return true, nil
+}
+
+func (sr *solutionRepo) FinishRejudgeJob(taskId uint64) error {
+	return nil
+}
+
+func (sr *solutionRepo) GetUserTaskState(taskId uint64, uid uint64) (bool, bool, error) {
+	accepted := false
+	for _, upd := range sr.updates {
+		accepted = accepted || upd.Code == 0
+	}
+	return accepted, false, nil
+}
+
+func (sr *solutionRepo) PublishEvent(uid uint64, event *models.SolutionEvent) error {
+	sr.events = append(sr.events, *event)
+	return nil
+}
+
+// GetSolutions pages over solutions with ids from 1 to 25, newest first.
+func (sr *solutionRepo) GetSolutions(f models.SolutionFilter) (models.SolutionsSQL, error) {
+	sr.filter = f
+	slns := models.SolutionsSQL{}
+	for id := uint64(25); id > 0 && len(slns) < f.Count; id-- {
+		if f.Cursor == 0 || id < f.Cursor {
+			slns = append(slns, models.SolutionSQL{Id: id, Uid: f.Uid})
+		}
+	}
+	return slns, nil
+}
+
+type taskUseCase struct {
+	task.UseCase
+	done      map[uint64]uint64
+	languages []string
+	subtasks  models.TaskSubtasks
+	// the task is private and the user has no access to it
+	private bool
+}
+
+func (tu *taskUseCase) GetTask(id uint64, uid uint64, forCheck bool) (*models.Task, error) {
+	return &models.Task{
+		Id:          id,
+		TestsAmount: 2,
+		Tests:       models.TaskTests{{Stdin: "1 2", Stdout: "3"}, {Stdin: "3 4", Stdout: "7"}},
+		Languages:   tu.languages,
+		Subtasks:    tu.subtasks,
+		Revision:    3,
+	}, nil
+}
+
+func (tu *taskUseCase) GetTaskToJudge(id uint64) (*models.Task, error) {
+	return tu.GetTask(id, 0, true)
+}
+
+func (tu *taskUseCase) GetTaskSettings(id uint64, uid uint64) (*models.Task, error) {
+	if tu.private {
+		return &models.Task{}, echo.NewHTTPError(http.StatusNotFound, "task not found")
+	}
+	return &models.Task{Id: id, TestsAmount: 2, Languages: tu.languages, Revision: 3}, nil
+}
+
+func (tu *taskUseCase) GetTaskAtRevision(id uint64, revision int) (*models.Task, error) {
+	if revision != 2 {
+		return &models.Task{}, echo.NewHTTPError(http.StatusNotFound, "no revision")
+	}
+	return &models.Task{Id: id, Tests: models.TaskTests{{Stdin: "1 2", Stdout: "4"}}, Revision: 2}, nil
+}
+
+func (tu *taskUseCase) CanManageTask(id uint64, uid uint64) (bool, error) {
+	return uid == 1, nil
+}
+
+func (tu *taskUseCase) MarkTaskDone(id uint64, uid uint64) error {
+	tu.done[uid] = id
+	return nil
+}
+
+func (tu *taskUseCase) UnmarkTaskDone(id uint64, uid uint64) error {
+	delete(tu.done, uid)
+	return nil
+}
+
+type queue struct {
+	judge.Queue
+	jobs []models.JudgeJob
+}
+
+func (q *queue) Push(job *models.JudgeJob) error {
+	q.jobs = append(q.jobs, *job)
+	return nil
+}
+
+func submit(t *testing.T, judgeFunc fake.JudgeFunc) (*solutionRepo, *taskUseCase) {
+	repo, tuc, _ := submitWith(t, judgeFunc)
+	return repo, tuc
+}
+
+func submitWith(t *testing.T, judgeFunc fake.JudgeFunc) (*solutionRepo, *taskUseCase, solution.UseCase) {
+	repo := &solutionRepo{
+		updates: map[uint64]models.SolutionUpdate{},
+		nonces:  map[uint64]string{},
+		seen:    map[string]bool{},
+	}
+	tuc := &taskUseCase{done: map[uint64]uint64{}}
+	q := &queue{}
+	uc := usecase.NewSolutionUseCase(repo, tuc, fake.NewFakeChecker(judgeFunc), q)
+
+	id, err := uc.SubmitSolution(7, 1, "", map[string]interface{}{"main.c": "int main() {}"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if id != 42 {
+		t.Errorf("expected solution id 42, got %d", id)
+	}
+	if len(q.jobs) != 1 || q.jobs[0].SolutionId != 42 {
+		t.Fatalf("solution was not queued: %v", q.jobs)
+	}
+	if len(repo.updates) != 0 {
+		t.Errorf("solution must not be checked before a worker takes it")
+	}
+
+	if err = uc.JudgeSolution(q.jobs[0]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return repo, tuc, uc
+}
+
+func TestSubmitAccepted(t *testing.T) {
+	repo, tuc := submit(t, nil)
+
+	if repo.updates[42].Passed != 2 {
+		t.Errorf("expected 2 passed tests, got %d", repo.updates[42].Passed)
+	}
+	if tuc.done[1] != 7 {
+		t.Errorf("task was not marked as done")
+	}
+	if repo.updates[42].Score != 100 || repo.best != 100 {
+		t.Errorf("accepted solution must get all points, got %d", repo.updates[42].Score)
+	}
+	if repo.updates[42].TaskRevision != 3 {
+		t.Errorf("solution must record the task revision it was judged on")
+	}
+
+	statuses := []string{}
+	for _, e := range repo.events {
+		statuses = append(statuses, e.Status)
+	}
+	expected := []string{models.SolutionStatusQueued, models.SolutionStatusCompiling, models.SolutionStatusChecked}
+	if len(statuses) != len(expected) {
+		t.Fatalf("expected statuses %v, got %v", expected, statuses)
+	}
+	for i := range expected {
+		if statuses[i] != expected[i] {
+			t.Errorf("expected statuses %v, got %v", expected, statuses)
+		}
+	}
+}
+
+func TestSubmitRejected(t *testing.T) {
+	repo, tuc := submit(t, func(ss *models.SolutionSend) models.SolutionUpdate {
+		return models.SolutionUpdate{Code: models.VerdictRuntimeError, Passed: 1, TestsTotal: len(ss.Tests)}
+	})
+
+	if repo.updates[42].Code != models.VerdictRuntimeError {
+		t.Errorf("expected runtime error, got %s", repo.updates[42].Code)
+	}
+	if _, ok := tuc.done[1]; ok {
+		t.Errorf("task must not be marked as done")
+	}
+}
+
+func TestCallbackNonce(t *testing.T) {
+	var nonce string
+	repo, _, uc := submitWith(t, func(ss *models.SolutionSend) models.SolutionUpdate {
+		nonce = ss.Nonce
+		return fake.AcceptAll(ss)
+	})
+
+	if nonce == "" {
+		t.Fatalf("nonce was not sent to the checker")
+	}
+	if err := uc.CheckCallback(42, nonce, "sig1"); err == nil {
+		t.Errorf("callback after the final verdict must be rejected")
+	}
+
+	// pretend the job is still running
+	repo.nonces[42] = nonce
+
+	if err := uc.CheckCallback(42, "forged", "sig2"); err == nil {
+		t.Errorf("callback with a wrong nonce must be rejected")
+	}
+	if err := uc.CheckCallback(42, nonce, "sig3"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := uc.CheckCallback(42, nonce, "sig3"); err == nil {
+		t.Errorf("replayed callback must be rejected")
+	}
+}
+
+func TestCallbackPublishesOnly(t *testing.T) {
+	repo, _, uc := submitWith(t, nil)
+	judged := repo.updates[42]
+	events := len(repo.events)
+
+	if err := uc.UpdateSolution(42, models.SolutionUpdate{Status: models.SolutionStatusRunning, CurrentTest: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.events) != events+1 || repo.events[events].Status != models.SolutionStatusRunning {
+		t.Errorf("intermediate status must be published")
+	}
+
+	// the verdict comes from the checker through the judge only
+	for _, status := range []string{"", models.SolutionStatusChecked} {
+		if err := uc.UpdateSolution(42, models.SolutionUpdate{Status: status, Code: models.VerdictWrongAnswer}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if repo.updates[42].Code != judged.Code || repo.updates[42].Score != judged.Score {
+		t.Errorf("final update from a callback must not be stored")
+	}
+}
+
+func TestRejudgeTakesTaskBack(t *testing.T) {
+	verdict := models.VerdictAccepted
+	repo, tuc, uc := submitWith(t, func(ss *models.SolutionSend) models.SolutionUpdate {
+		return models.SolutionUpdate{Code: verdict, TestsTotal: len(ss.Tests)}
+	})
+	if tuc.done[1] != 7 {
+		t.Fatalf("task was not marked as done")
+	}
+
+	// tests changed and the solution does not pass anymore
+	verdict = models.VerdictRuntimeError
+	job := models.JudgeJob{SolutionId: 42, TaskId: 7, Uid: 1, Rejudge: true}
+	if err := uc.JudgeSolution(job); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if repo.updates[42].Code != models.VerdictRuntimeError {
+		t.Errorf("expected runtime error, got %s", repo.updates[42].Code)
+	}
+	if _, ok := tuc.done[1]; ok {
+		t.Errorf("task must be taken back after rejudge")
+	}
+}
+
+func TestSubmitLanguage(t *testing.T) {
+	repo := &solutionRepo{updates: map[uint64]models.SolutionUpdate{}}
+	tuc := &taskUseCase{done: map[uint64]uint64{}, languages: []string{"python"}}
+	checker := fake.NewFakeChecker(nil)
+	q := &queue{}
+	uc := usecase.NewSolutionUseCase(repo, tuc, checker, q)
+
+	if _, err := uc.SubmitSolution(7, 1, "", map[string]interface{}{"main.c": "int main() {}"}); err == nil {
+		t.Errorf("language not allowed for the task must be rejected")
+	}
+	if _, err := uc.SubmitSolution(7, 1, "python", map[string]interface{}{"main.c": "int main() {}"}); err == nil {
+		t.Errorf("files of another language must be rejected")
+	}
+	if _, err := uc.SubmitSolution(7, 1, "brainfuck", map[string]interface{}{"main.bf": "+"}); err == nil {
+		t.Errorf("unknown language must be rejected")
+	}
+	if len(q.jobs) != 0 {
+		t.Fatalf("rejected solutions must not be queued")
+	}
+
+	if _, err := uc.SubmitSolution(7, 1, "python", map[string]interface{}{"main.py": "print(3)"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.lang != "python" {
+		t.Errorf("expected python solution to be stored, got %q", repo.lang)
+	}
+}
+
+func TestSolutionsHistory(t *testing.T) {
+	repo := &solutionRepo{updates: map[uint64]models.SolutionUpdate{}}
+	tuc := &taskUseCase{done: map[uint64]uint64{}}
+	uc := usecase.NewSolutionUseCase(repo, tuc, fake.NewFakeChecker(nil), &queue{})
+
+	slns, next, err := uc.GetSolutions(models.SolutionFilter{TaskId: 7, Uid: 3}, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo.filter.Uid != 2 {
+		t.Errorf("users must see only their own solutions, got filter by %d", repo.filter.Uid)
+	}
+	if len(slns) != 10 || slns[0].Id != 25 || next != 16 {
+		t.Errorf("wrong first page: %d solutions, next %d", len(slns), next)
+	}
+
+	ids := []uint64{}
+	for next != 0 {
+		if slns, next, err = uc.GetSolutions(models.SolutionFilter{Cursor: next, Count: 10}, 2); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, sln := range slns {
+			ids = append(ids, sln.Id)
+		}
+	}
+	if len(ids) != 15 || ids[0] != 15 || ids[14] != 1 {
+		t.Errorf("wrong next pages: %v", ids)
+	}
+
+	if _, _, err = uc.GetSolutions(models.SolutionFilter{TaskId: 7, AllUsers: true}, 2); err == nil {
+		t.Errorf("solutions of all users must be shown to the author only")
+	}
+	if _, _, err = uc.GetSolutions(models.SolutionFilter{TaskId: 7, AllUsers: true}, 1); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if repo.filter.Uid != 0 {
+		t.Errorf("author must see solutions of all users")
+	}
+}
+
+func TestRunIsRateLimited(t *testing.T) {
+	repo := &solutionRepo{updates: map[uint64]models.SolutionUpdate{}}
+	tuc := &taskUseCase{done: map[uint64]uint64{}}
+	checker := fake.NewFakeChecker(nil)
+	q := &queue{}
+	uc := usecase.NewSolutionUseCase(repo, tuc, checker, q)
+
+	run := &models.RunRequest{Language: "python", SourceCode: map[string]interface{}{"main.py": "print(input())"}, Stdin: "1"}
+	for i := 0; i < constants.RunRateLimit; i++ {
+		res, err := uc.RunSolution(7, 1, run)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Verdict != models.VerdictAccepted {
+			t.Errorf("expected accepted run, got %s", res.Verdict)
+		}
+	}
+
+	if _, err := uc.RunSolution(7, 1, run); err == nil {
+		t.Errorf("runs over the limit must be rejected")
+	}
+	if len(q.jobs) != 0 || repo.code != nil {
+		t.Errorf("runs must not be stored or queued")
+	}
+}
+
+func TestSolutionTestsOfRevision(t *testing.T) {
+	repo := &solutionRepo{}
+	uc := usecase.NewSolutionUseCase(repo, &taskUseCase{}, fake.NewFakeChecker(nil), &queue{})
+
+	for revision, expected := range map[int]string{3: "3", 2: "4", 1: "", 0: ""} {
+		repo.revision = revision
+		full, err := uc.GetSolution(42, 7, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(full.Tests) != 1 || full.Tests[0].ExpectedStdout != expected || full.Tests[0].Stdout != "3" {
+			t.Errorf("revision %d: wrong tests %+v", revision, full.Tests)
+		}
+	}
+}
+
+func TestRerunSolution(t *testing.T) {
+	repo := &solutionRepo{verdict: models.VerdictPending}
+	q := &queue{}
+	uc := usecase.NewSolutionUseCase(repo, &taskUseCase{}, fake.NewFakeChecker(nil), q)
+
+	if err := uc.RerunSolution(42, 7, 1); err == nil {
+		t.Errorf("solution waiting for a verdict must not be queued again")
+	}
+
+	for i := 0; i < constants.RunRateLimit; i++ {
+		repo.verdict = models.VerdictWrongAnswer
+		if err := uc.RerunSolution(42, 7, 1); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(q.jobs) != constants.RunRateLimit {
+		t.Errorf("expected %d jobs, got %d", constants.RunRateLimit, len(q.jobs))
+	}
+
+	repo.verdict = models.VerdictWrongAnswer
+	if err := uc.RerunSolution(42, 7, 1); err == nil {
+		t.Errorf("reruns over the limit must be rejected")
+	}
+
+	repo = &solutionRepo{verdict: models.VerdictWrongAnswer}
+	q = &queue{}
+	uc = usecase.NewSolutionUseCase(repo, &taskUseCase{private: true}, fake.NewFakeChecker(nil), q)
+	if err := uc.RerunSolution(42, 7, 1); err == nil || len(q.jobs) != 0 {
+		t.Errorf("solution of a task the user lost access to must not be judged again")
+	}
+}
+
+func TestReviewThreads(t *testing.T) {
+	repo := &solutionRepo{code: map[string]interface{}{"main.c": "int main() {\n}\n"}}
+	tuc := &taskUseCase{done: map[uint64]uint64{}}
+	uc := usecase.NewSolutionUseCase(repo, tuc, fake.NewFakeChecker(nil), &queue{})
+
+	if _, err := uc.GetReviews(7, 42, 2); err == nil {
+		t.Errorf("reviews of other users' solutions must be hidden")
+	}
+
+	for _, th := range []models.ReviewThreadNew{
+		{FileName: "main.c", LineStart: 2, LineEnd: 3, Text: "wrong lines"},
+		{FileName: "util.c", LineStart: 1, LineEnd: 1, Text: "no such file"},
+		{FileName: "main.c", LineStart: 1, LineEnd: 1, Text: "  "},
+	} {
+		if _, err := uc.CreateReviewThread(7, 42, 1, &th); err == nil {
+			t.Errorf("thread %+v must be rejected", th)
+		}
+	}
+
+	th, err := uc.CreateReviewThread(7, 42, 1, &models.ReviewThreadNew{
+		FileName: "main.c", LineStart: 1, LineEnd: 2, Text: " return something "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.Id != 1 || th.LineEnd != 2 || len(th.Comments) != 1 || th.Comments[0].Text != "return something" {
+		t.Errorf("wrong thread: %+v", th)
+	}
+}
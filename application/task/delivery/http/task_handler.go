@@ -1,6 +1,7 @@
 package http
 
 import (
+	"io/ioutil"
 	"liokoredu/application/models"
 	"liokoredu/application/server/middleware"
 	"liokoredu/application/task"
@@ -9,6 +10,7 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo"
 
@@ -33,11 +35,28 @@ func CreateTaskHandler(e *echo.Echo,
 	e.GET("/api/v1/tasks/search", taskHandler.findTasks)
 	e.GET("/api/v1/tasks/fullsearch", taskHandler.findTasksFull)
 	e.GET("/api/v1/tasks/pages", taskHandler.getPages)
+	e.GET("/api/v1/tasks/tags", taskHandler.getTags)
 	e.GET("/api/v1/tasks/solved", taskHandler.getSolvedTasks, a.GetSession)
 	e.GET("/api/v1/tasks/unsolved", taskHandler.getUnsolvedTasks, a.GetSession)
 	e.GET("/api/v1/tasks/user", taskHandler.getUserTasks, a.GetSession)
 	e.DELETE("/api/v1/tasks/:id", taskHandler.deleteTask, a.GetSession)
 	e.PUT("/api/v1/tasks/:id", taskHandler.updateTask, a.GetSession)
+	e.GET("/api/v1/tasks/:id/tests", taskHandler.getTaskTests, a.GetSession)
+	e.POST("/api/v1/tasks/:id/tests", taskHandler.uploadTests, a.GetSession)
+	e.DELETE("/api/v1/tasks/:id/tests", taskHandler.dropTaskTests, a.GetSession)
+	e.GET("/api/v1/tasks/:id/generator", taskHandler.getTaskGenerator, a.GetSession)
+	e.PUT("/api/v1/tasks/:id/generator", taskHandler.saveTaskGenerator, a.GetSession)
+	e.POST("/api/v1/tasks/:id/tests/generate", taskHandler.generateTests, a.GetSession)
+	e.GET("/api/v1/tasks/:id/revisions", taskHandler.getTaskRevisions, a.GetSession)
+	e.GET("/api/v1/tasks/:id/revisions/diff", taskHandler.diffRevisions, a.GetSession)
+	e.POST("/api/v1/tasks/:id/revisions/:revision/rollback", taskHandler.rollbackTask, a.GetSession)
+	e.POST("/api/v1/tasks/join/:code", taskHandler.joinTask, a.GetSession)
+	e.GET("/api/v1/tasks/:id/access", taskHandler.getTaskAccess, a.GetSession)
+	e.PUT("/api/v1/tasks/:id/access", taskHandler.setTaskPrivate, a.GetSession)
+	e.POST("/api/v1/tasks/:id/access/invite", taskHandler.renewInvite, a.GetSession)
+	e.DELETE("/api/v1/tasks/:id/access/invite", taskHandler.revokeInvite, a.GetSession)
+	e.POST("/api/v1/tasks/:id/access/grants", taskHandler.grantTask, a.GetSession)
+	e.DELETE("/api/v1/tasks/:id/access/grants/:uid", taskHandler.revokeGrant, a.GetSession)
 }
 
 func (th *TaskHandler) getTask(c echo.Context) error {
@@ -87,7 +106,22 @@ func (th *TaskHandler) getPages(c echo.Context) error {
 		cc = constants.TasksPerPage
 	}
 
-	n, err := th.uc.GetPages(cc)
+	uid := uint64(0)
+
+	cookie, err := c.Cookie(constants.SessionCookieName)
+	if err != nil && cookie != nil {
+		log.Println("user handler: getPages: error getting cookie")
+		return echo.NewHTTPError(http.StatusBadRequest, "error getting cookie")
+	}
+
+	if cookie != nil {
+		uid, err = th.uuc.CheckSession(cookie.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := th.uc.GetPages(uid, cc)
 	if err != nil {
 		return err
 	}
@@ -259,13 +293,33 @@ func (th *TaskHandler) findTasksFull(c echo.Context) error {
 		return echo.NewHTTPError(401, "Cannot find user's tasks - not authenticated")
 	}
 
-	tsks, num, err := th.uc.FindTasksFull(str, useSolved, boolSolved, useMine, boolMine, uid, p, cc)
+	f := models.TaskFilter{
+		Find:        str,
+		Uid:         uid,
+		UseSolved:   useSolved,
+		Solved:      boolSolved,
+		Mine:        boolMine,
+		Tags:        splitList(c.QueryParam(constants.TagsKey)),
+		ExcludeTags: splitList(c.QueryParam(constants.ExcludeTagsKey)),
+		Page:        p,
+		Count:       cc,
+	}
+	for key, value := range map[string]*int{
+		constants.MinDifficultyKey: &f.MinDifficulty,
+		constants.MaxDifficultyKey: &f.MaxDifficulty,
+	} {
+		if param := c.QueryParam(key); param != "" {
+			if *value, err = strconv.Atoi(param); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "Bad parameters")
+			}
+		}
+	}
+
+	tsksNum, err := th.uc.FindTasksFull(f)
 	if err != nil {
 		return err
 	}
 
-	tsksNum := models.TasksWithNum{Tsks: tsks, Num: num}
-
 	if _, err = easyjson.MarshalToWriter(tsksNum, c.Response().Writer); err != nil {
 		log.Println("task handler: findTasks: error marshaling answer to writer", err)
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -436,3 +490,366 @@ func (th *TaskHandler) updateTask(c echo.Context) error {
 
 	return nil
 }
+
+func (th *TaskHandler) getTaskTests(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	tests, err := th.uc.GetTaskTests(id, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(tests, c.Response().Writer); err != nil {
+		log.Println(c, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+// uploadTests takes a multipart form with the ZIP archive of tests and
+// optional comma-separated numbers of sample and hidden tests, the numbers
+// are the ones of NN.in and NN.out files in the archive.
+func (th *TaskHandler) uploadTests(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	fh, err := c.FormFile(constants.ArchiveKey)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "archive with tests is required")
+	}
+	if fh.Size > constants.TestsArchiveSize {
+		return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "archive is too large")
+	}
+	f, err := fh.Open()
+	if err != nil {
+		log.Println("task handler: uploadTests: error opening archive", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	defer f.Close()
+	archive, err := ioutil.ReadAll(f)
+	if err != nil {
+		log.Println("task handler: uploadTests: error reading archive", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	samples, err := parseTestNums(c.FormValue(constants.SamplesKey))
+	if err != nil {
+		return err
+	}
+	hidden, err := parseTestNums(c.FormValue(constants.HiddenKey))
+	if err != nil {
+		return err
+	}
+
+	tests, err := th.uc.UploadTests(id, uid, archive, samples, hidden)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(tests, c.Response().Writer); err != nil {
+		log.Println(c, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (th *TaskHandler) dropTaskTests(c echo.Context) error {
+	defer c.Request().Body.Close()
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	return th.uc.DropTaskTests(id, uid)
+}
+
+func (th *TaskHandler) getTags(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	tags, err := th.uc.GetTags()
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(tags, c.Response().Writer); err != nil {
+		log.Println("task handler: getTags: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+// splitList splits a comma-separated query parameter.
+func splitList(value string) []string {
+	res := []string{}
+	for _, s := range strings.Split(value, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func parseTestNums(value string) ([]int, error) {
+	nums := []int{}
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		num, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, "wrong test number "+s)
+		}
+		nums = append(nums, num)
+	}
+	return nums, nil
+}
+
+func (th *TaskHandler) getTaskGenerator(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	g, err := th.uc.GetTaskGenerator(id, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(g, c.Response().Writer); err != nil {
+		log.Println(c, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (th *TaskHandler) saveTaskGenerator(c echo.Context) error {
+	defer c.Request().Body.Close()
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	g := &models.TaskGenerator{}
+	if err := easyjson.UnmarshalFromReader(c.Request().Body, g); err != nil {
+		log.Println("task handler: saveTaskGenerator: error unmarshaling generator from reader", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return th.uc.SaveTaskGenerator(id, uid, g)
+}
+
+// generateTests rebuilds tests of the task with its stored generator.
+func (th *TaskHandler) generateTests(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	tests, err := th.uc.GenerateTests(id, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(tests, c.Response().Writer); err != nil {
+		log.Println(c, err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (th *TaskHandler) getTaskRevisions(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	revs, err := th.uc.GetTaskRevisions(id, uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(revs, c.Response().Writer); err != nil {
+		log.Println("task handler: getTaskRevisions: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (th *TaskHandler) diffRevisions(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	from, err := strconv.Atoi(c.QueryParam(constants.FromKey))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "bad revision to diff from")
+	}
+	to, err := strconv.Atoi(c.QueryParam(constants.ToKey))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "bad revision to diff to")
+	}
+
+	d, err := th.uc.DiffRevisions(id, uid, from, to)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(d, c.Response().Writer); err != nil {
+		log.Println("task handler: diffRevisions: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (th *TaskHandler) rollbackTask(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+	revision, err := strconv.Atoi(c.Param(constants.RevisionKey))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "bad revision")
+	}
+
+	t, err := th.uc.RollbackTask(id, uid, revision)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(t, c.Response().Writer); err != nil {
+		log.Println("task handler: rollbackTask: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (th *TaskHandler) joinTask(c echo.Context) error {
+	defer c.Request().Body.Close()
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+
+	t, err := th.uc.JoinTask(c.Param(constants.CodeKey), uid)
+	if err != nil {
+		return err
+	}
+
+	if _, err = easyjson.MarshalToWriter(t, c.Response().Writer); err != nil {
+		log.Println("task handler: joinTask: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (th *TaskHandler) getTaskAccess(c echo.Context) error {
+	defer c.Request().Body.Close()
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	access, err := th.uc.GetTaskAccess(id, uid)
+	return sendAccess(c, access, err)
+}
+
+func (th *TaskHandler) setTaskPrivate(c echo.Context) error {
+	defer c.Request().Body.Close()
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	upd := &models.TaskAccessUpdate{}
+	if err := easyjson.UnmarshalFromReader(c.Request().Body, upd); err != nil {
+		log.Println("task handler: setTaskPrivate: error unmarshaling access from reader", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	access, err := th.uc.SetTaskPrivate(id, uid, upd.IsPrivate)
+	return sendAccess(c, access, err)
+}
+
+func (th *TaskHandler) renewInvite(c echo.Context) error {
+	defer c.Request().Body.Close()
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	access, err := th.uc.RenewInvite(id, uid)
+	return sendAccess(c, access, err)
+}
+
+func (th *TaskHandler) revokeInvite(c echo.Context) error {
+	defer c.Request().Body.Close()
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	access, err := th.uc.RevokeInvite(id, uid)
+	return sendAccess(c, access, err)
+}
+
+func (th *TaskHandler) grantTask(c echo.Context) error {
+	defer c.Request().Body.Close()
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+
+	g := &models.TaskGrantNew{}
+	if err := easyjson.UnmarshalFromReader(c.Request().Body, g); err != nil {
+		log.Println("task handler: grantTask: error unmarshaling grant from reader", err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	access, err := th.uc.GrantTask(id, uid, g.Username)
+	return sendAccess(c, access, err)
+}
+
+func (th *TaskHandler) revokeGrant(c echo.Context) error {
+	defer c.Request().Body.Close()
+
+	uid := c.Get(constants.UserIdKey).(uint64)
+	id, _ := strconv.ParseUint(c.Param(constants.IdKey), 10, 64)
+	grantee, err := strconv.ParseUint(c.Param(constants.UserIdKey), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "bad user id")
+	}
+
+	access, err := th.uc.RevokeGrant(id, uid, grantee)
+	return sendAccess(c, access, err)
+}
+
+// sendAccess writes access of the task unless it failed to change.
+func sendAccess(c echo.Context, access models.TaskAccess, err error) error {
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+	if _, err = easyjson.MarshalToWriter(access, c.Response().Writer); err != nil {
+		log.Println("task handler: sendAccess: error marshaling answer to writer", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
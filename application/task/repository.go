@@ -4,8 +4,8 @@ import "liokoredu/application/models"
 
 type Repository interface {
 	GetTask(id uint64) (*models.TaskSQL, error)
-	GetPages() (int, error)
-	GetTasks(page int, count int) (*models.ShortTasks, error)
+	GetPages(uid uint64) (int, error)
+	GetTasks(uid uint64, page int, count int) (*models.ShortTasks, error)
 	GetSolvedTasks(uid uint64, page int, count int) (*models.ShortTasks, error)
 	GetUnsolvedTasks(uid uint64, page int, count int) (*models.ShortTasks, error)
 	IsCleared(taskId uint64, uid uint64) (bool, error)
@@ -13,7 +13,30 @@ type Repository interface {
 	CreateTask(t *models.TaskSQL) (uint64, error)
 	DeleteTask(id uint64, uid uint64) error
 	UpdateTask(t *models.TaskSQL) error
+	GetBestScore(taskId uint64, uid uint64) (int, error)
 	MarkTaskDone(id uint64, uid uint64) error
-	FindTasks(str string, page int, count int) (*models.ShortTasks, error)
-	FindTasksFull(str string, useSolved bool, solved bool, useMine bool, mine bool, uid uint64, page int, count int) (*models.ShortTasks, int, error)
+	UnmarkTaskDone(id uint64, uid uint64) error
+	CanManageTask(id uint64, uid uint64) (bool, error)
+	GetTaskTests(taskId uint64) (models.TaskTestsSQL, error)
+	GetTestData(key string) (string, error)
+	ReplaceTaskTests(taskId uint64, uid uint64, tests models.TaskTests) error
+	DropTaskTests(taskId uint64, uid uint64) error
+	GetTaskGenerator(taskId uint64) (*models.TaskGeneratorSQL, error)
+	SaveTaskGenerator(g models.TaskGeneratorSQL) error
+	FindTasks(str string, uid uint64, page int, count int) (*models.ShortTasks, error)
+	FindTasksFull(f models.TaskFilter) (*models.ShortTasks, int, error)
+	GetTaskFacets(f models.TaskFilter) (models.TaskFacets, error)
+	SetTaskTags(taskId uint64, tags []string) error
+	GetTaskTags(taskId uint64) ([]string, error)
+	GetTags() (models.TagCounts, error)
+	GetTaskRevisions(taskId uint64) (models.TaskRevisionsSQL, error)
+	GetTaskRevision(taskId uint64, revision int) (*models.TaskSQL, models.TaskTestsSQL, error)
+	RollbackTask(taskId uint64, revision int, uid uint64) error
+	CanViewTask(id uint64, uid uint64) (bool, error)
+	GetTaskAccess(id uint64) (models.TaskAccess, error)
+	SetTaskPrivate(id uint64, private bool, code string) error
+	SetInviteCode(id uint64, code string) error
+	JoinTask(code string, uid uint64) (uint64, error)
+	GrantTask(id uint64, username string) error
+	RevokeGrant(id uint64, uid uint64) error
 }
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"liokoredu/application/models"
+	"log"
+	"net/http"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/labstack/echo"
+)
+
+// visibleTask is the condition on tasks t seen by the user in listings:
+// public ones, own ones and the ones granted to the user.
+func visibleTask(uid string) string {
+	return `(t.is_private = false OR t.creator = ` + uid + `
+		OR EXISTS (SELECT 1 FROM task_grants g WHERE g.task_id = t.id AND g.uid = ` + uid + `))`
+}
+
+// CanViewTask implements task.Repository
+// Admins can see every task as they can manage every task.
+func (td *TaskDatabase) CanViewTask(id uint64, uid uint64) (bool, error) {
+	var allowed bool
+	err := td.pool.QueryRow(context.Background(),
+		`SELECT EXISTS (SELECT 1 FROM tasks t WHERE t.id = $1 AND `+visibleTask("$2")+`)
+			OR EXISTS (SELECT 1 FROM users WHERE id = $2 AND is_admin = true)`,
+		id, uid).Scan(&allowed)
+
+	if err != nil {
+		log.Println("task repository: CanViewTask: error checking access:", err)
+		return false, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return allowed, nil
+}
+
+func (td *TaskDatabase) GetTaskAccess(id uint64) (models.TaskAccess, error) {
+	access := models.TaskAccess{Grants: models.TaskGrants{}}
+	err := td.pool.QueryRow(context.Background(),
+		`SELECT is_private, COALESCE(code, '') FROM tasks WHERE id = $1`, id).Scan(&access.IsPrivate, &access.Code)
+	if err != nil {
+		log.Println("task repository: GetTaskAccess: error getting task:", err)
+		return models.TaskAccess{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	err = pgxscan.Select(context.Background(), td.pool, &access.Grants,
+		`SELECT g.uid, u.username, g.date FROM task_grants g JOIN users u ON u.id = g.uid
+		WHERE g.task_id = $1 ORDER BY g.date`, id)
+	if err != nil {
+		log.Println("task repository: GetTaskAccess: error getting grants:", err)
+		return models.TaskAccess{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return access, nil
+}
+
+// SetTaskPrivate implements task.Repository
+// A task made private keeps its invite or gets the given one, a public
+// task has none.
+func (td *TaskDatabase) SetTaskPrivate(id uint64, private bool, code string) error {
+	_, err := td.pool.Exec(context.Background(),
+		`UPDATE tasks SET is_private = $2, code = CASE WHEN $2 THEN COALESCE(code, $3) END WHERE id = $1`,
+		id, private, code)
+	if err != nil {
+		log.Println("task repository: SetTaskPrivate: error updating task:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+// SetInviteCode implements task.Repository
+// An empty code revokes the invite, users who have joined keep their grants.
+func (td *TaskDatabase) SetInviteCode(id uint64, code string) error {
+	resp, err := td.pool.Exec(context.Background(),
+		`UPDATE tasks SET code = $2 WHERE id = $1 AND is_private = true`, id, models.NewNullString(code))
+	if err != nil {
+		log.Println("task repository: SetInviteCode: error updating task:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if resp.RowsAffected() == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "task is not private")
+	}
+
+	return nil
+}
+
+// JoinTask implements task.Repository
+// It grants the user the private task with the invite and returns its id.
+func (td *TaskDatabase) JoinTask(code string, uid uint64) (uint64, error) {
+	var ids []uint64
+	err := pgxscan.Select(context.Background(), td.pool, &ids,
+		`SELECT id FROM tasks WHERE code = $1 AND is_private = true`, code)
+	if err != nil {
+		log.Println("task repository: JoinTask: error getting task:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if len(ids) == 0 {
+		return 0, echo.NewHTTPError(http.StatusNotFound, "invite is invalid or revoked")
+	}
+
+	if err = td.grant(ids[0], uid); err != nil {
+		return 0, err
+	}
+
+	return ids[0], nil
+}
+
+func (td *TaskDatabase) GrantTask(id uint64, username string) error {
+	var uids []uint64
+	err := pgxscan.Select(context.Background(), td.pool, &uids,
+		`SELECT id FROM users WHERE username = $1`, username)
+	if err != nil {
+		log.Println("task repository: GrantTask: error getting user:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if len(uids) == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "user "+username+" not found")
+	}
+
+	return td.grant(id, uids[0])
+}
+
+func (td *TaskDatabase) grant(id uint64, uid uint64) error {
+	_, err := td.pool.Exec(context.Background(),
+		`INSERT INTO task_grants (task_id, uid, date) VALUES ($1, $2, now()) ON CONFLICT DO NOTHING`, id, uid)
+	if err != nil {
+		log.Println("task repository: grant: error granting task:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (td *TaskDatabase) RevokeGrant(id uint64, uid uint64) error {
+	resp, err := td.pool.Exec(context.Background(),
+		`DELETE FROM task_grants WHERE task_id = $1 AND uid = $2`, id, uid)
+	if err != nil {
+		log.Println("task repository: RevokeGrant: error deleting grant:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if resp.RowsAffected() == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "user has no grant")
+	}
+
+	return nil
+}
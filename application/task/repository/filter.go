@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"liokoredu/application/models"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/labstack/echo"
+)
+
+// shortTaskColumns are selected for task listings from tasks t joined with
+// users u on the creator and with userTaskJoins.
+const shortTaskColumns = `t.id, t.title, t.description, t.test_amount, t.max_score, t.difficulty,
+	t.creator AS creator_id, u.username AS creator,
+	ARRAY(SELECT tg.name FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id
+		WHERE tt.task_id = t.id ORDER BY tg.name) AS tags,
+	COALESCE(sc.score, 0) AS score, done.task_id IS NOT NULL AS is_cleared`
+
+// userTaskJoins join the best score of the user and the mark of the task
+// done by the user to tasks t, anonymous users get neither.
+func userTaskJoins(uid string) string {
+	return ` LEFT JOIN task_scores sc ON sc.task_id = t.id AND sc.uid = ` + uid + `
+		LEFT JOIN (SELECT DISTINCT task_id FROM tasks_done WHERE uid = ` + uid + `) done ON done.task_id = t.id`
+}
+
+// taskColumns are all columns of models.TaskSQL, the search column is
+// only for the index.
+const taskColumns = `id, title, description, hints, input, output, test_amount, tests, tests_key,
+	subtasks, max_score, difficulty, revision, checker, time_limit, memory_limit, output_limit, allowed_languages,
+	creator, is_private, code, date`
+
+// headlineOptions keep search snippets short.
+const headlineOptions = `'StartSel=<b>, StopSel=</b>, MaxWords=30, MinWords=10, MaxFragments=2'`
+
+// taskQuery collects conditions on tasks t with their arguments.
+type taskQuery struct {
+	where []string
+	args  []interface{}
+	// tsquery of the search, empty without it
+	search string
+	// placeholder of the user
+	uid string
+}
+
+// arg adds an argument and returns its placeholder.
+func (q *taskQuery) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+func (q *taskQuery) add(cond string) {
+	q.where = append(q.where, cond)
+}
+
+func (q *taskQuery) whereSQL() string {
+	return " WHERE " + strings.Join(q.where, " AND ")
+}
+
+// hasTag checks tags of the task with a condition on their names.
+func hasTag(cond string) string {
+	return `EXISTS (SELECT 1 FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id
+		WHERE tt.task_id = t.id AND tg.name ` + cond + `)`
+}
+
+func newTaskQuery(f models.TaskFilter, withDifficulty bool) *taskQuery {
+	q := &taskQuery{}
+	q.uid = q.arg(f.Uid)
+	q.add(visibleTask(q.uid))
+
+	if ts := models.TextSearchQuery(f.Find); ts != "" {
+		s := q.arg(ts)
+		q.search = `(to_tsquery('russian', ` + s + `) || to_tsquery('english', ` + s + `))`
+		cond := "t.search @@ " + q.search
+		if id, err := strconv.ParseUint(strings.TrimSpace(f.Find), 10, 64); err == nil {
+			cond = "(" + cond + " OR t.id = " + q.arg(id) + ")"
+		}
+		q.add(cond)
+	}
+	if f.Mine {
+		q.add("t.creator = " + q.uid)
+	}
+	if f.UseSolved {
+		done := "EXISTS (SELECT 1 FROM tasks_done d WHERE d.uid = " + q.uid + " AND d.task_id = t.id)"
+		if !f.Solved {
+			done = "NOT " + done
+		}
+		q.add(done)
+	}
+	for _, tag := range f.Tags {
+		q.add(hasTag("= " + q.arg(tag)))
+	}
+	if len(f.ExcludeTags) > 0 {
+		q.add("NOT " + hasTag("= ANY("+q.arg(f.ExcludeTags)+")"))
+	}
+	if withDifficulty && f.MinDifficulty > 0 {
+		q.add("t.difficulty >= " + q.arg(f.MinDifficulty))
+	}
+	if withDifficulty && f.MaxDifficulty > 0 {
+		q.add("t.difficulty <= " + q.arg(f.MaxDifficulty))
+	}
+
+	return q
+}
+
+// selectTasks returns a page of tasks matching the query. Search results
+// are ordered by rank and come with headlines, others are newest first.
+func (td *TaskDatabase) selectTasks(q *taskQuery, page int, count int) (models.ShortTasks, error) {
+	columns := shortTaskColumns
+	order := "t.id DESC"
+	if q.search != "" {
+		columns += `, ts_headline('russian', t.description, ` + q.search + `, ` + headlineOptions + `) AS headline`
+		order = "ts_rank(t.search, " + q.search + ") DESC, " + order
+	}
+
+	t := models.ShortTasks{}
+	err := pgxscan.Select(context.Background(), td.pool, &t,
+		`SELECT `+columns+` FROM tasks t JOIN users u ON u.id = t.creator`+userTaskJoins(q.uid)+q.whereSQL()+
+			` ORDER BY `+order+` LIMIT `+q.arg(count)+` OFFSET `+q.arg((page-1)*count), q.args...)
+	if err != nil {
+		return models.ShortTasks{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return t, nil
+}
+
+// FindTasks implements task.Repository
+func (td *TaskDatabase) FindTasks(str string, uid uint64, page int, count int) (*models.ShortTasks, error) {
+	t, err := td.selectTasks(newTaskQuery(models.TaskFilter{Find: str, Uid: uid}, false), page, count)
+	if err != nil {
+		log.Println("task repository: findTasks: error getting tasks", err)
+		return &models.ShortTasks{}, err
+	}
+
+	return &t, nil
+}
+
+// FindTasksFull implements task.Repository
+// It returns a page of tasks matching the filter and the number of all of them.
+func (td *TaskDatabase) FindTasksFull(f models.TaskFilter) (*models.ShortTasks, int, error) {
+	q := newTaskQuery(f, true)
+
+	var n int
+	err := td.pool.QueryRow(context.Background(),
+		`SELECT count(*) FROM tasks t`+q.whereSQL(), q.args...).Scan(&n)
+	if err != nil {
+		log.Println("task repository: FindTasksFull: error getting num:", err)
+		return &models.ShortTasks{}, 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	t, err := td.selectTasks(q, f.Page, f.Count)
+	if err != nil {
+		log.Println("task repository: FindTasksFull: error getting tasks", err)
+		return &models.ShortTasks{}, 0, err
+	}
+
+	return &t, n, nil
+}
+
+// GetTaskFacets implements task.Repository
+func (td *TaskDatabase) GetTaskFacets(f models.TaskFilter) (models.TaskFacets, error) {
+	facets := models.TaskFacets{Tags: models.TagCounts{}, Difficulties: models.DifficultyCounts{}}
+
+	q := newTaskQuery(f, true)
+	err := pgxscan.Select(context.Background(), td.pool, &facets.Tags,
+		`SELECT tg.name AS tag, count(*) AS count FROM tasks t
+		JOIN task_tags tt ON tt.task_id = t.id
+		JOIN tags tg ON tg.id = tt.tag_id`+q.whereSQL()+`
+		GROUP BY tg.name ORDER BY count DESC, tg.name`, q.args...)
+	if err != nil {
+		log.Println("task repository: GetTaskFacets: error counting tags", err)
+		return models.TaskFacets{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	q = newTaskQuery(f, false)
+	err = pgxscan.Select(context.Background(), td.pool, &facets.Difficulties,
+		`SELECT t.difficulty, count(*) AS count FROM tasks t`+q.whereSQL()+`
+		GROUP BY t.difficulty ORDER BY t.difficulty`, q.args...)
+	if err != nil {
+		log.Println("task repository: GetTaskFacets: error counting difficulties", err)
+		return models.TaskFacets{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return facets, nil
+}
+
+// SetTaskTags implements task.Repository
+// Unknown tags are added to the taxonomy.
+func (td *TaskDatabase) SetTaskTags(taskId uint64, tags []string) error {
+	tx, err := td.pool.Begin(context.Background())
+	if err != nil {
+		log.Println("task repository: SetTaskTags: error starting transaction:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer tx.Rollback(context.Background())
+
+	_, err = tx.Exec(context.Background(), `DELETE FROM task_tags WHERE task_id = $1`, taskId)
+	if err != nil {
+		log.Println("task repository: SetTaskTags: error deleting tags:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	_, err = tx.Exec(context.Background(),
+		`INSERT INTO tags (name) SELECT unnest($1::text[]) ON CONFLICT (name) DO NOTHING`, tags)
+	if err != nil {
+		log.Println("task repository: SetTaskTags: error adding tags:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	_, err = tx.Exec(context.Background(),
+		`INSERT INTO task_tags (task_id, tag_id) SELECT $1, id FROM tags WHERE name = ANY($2)`, taskId, tags)
+	if err != nil {
+		log.Println("task repository: SetTaskTags: error setting tags:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("task repository: SetTaskTags: error committing:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (td *TaskDatabase) GetTaskTags(taskId uint64) ([]string, error) {
+	tags := []string{}
+	err := pgxscan.Select(context.Background(), td.pool, &tags,
+		`SELECT tg.name FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id
+		WHERE tt.task_id = $1 ORDER BY tg.name`, taskId)
+	if err != nil {
+		log.Println("task repository: GetTaskTags: error getting tags", err)
+		return []string{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return tags, nil
+}
+
+// GetTags implements task.Repository
+// It returns the whole taxonomy with numbers of public tasks per tag.
+func (td *TaskDatabase) GetTags() (models.TagCounts, error) {
+	tags := models.TagCounts{}
+	err := pgxscan.Select(context.Background(), td.pool, &tags,
+		`SELECT tg.name AS tag, count(t.id) AS count FROM tags tg
+		LEFT JOIN task_tags tt ON tt.tag_id = tg.id
+		LEFT JOIN tasks t ON t.id = tt.task_id AND t.is_private = false
+		GROUP BY tg.name ORDER BY tg.name`)
+	if err != nil {
+		log.Println("task repository: GetTags: error getting tags", err)
+		return models.TagCounts{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"liokoredu/application/models"
+	"log"
+	"net/http"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/labstack/echo"
+)
+
+func (td *TaskDatabase) GetTaskGenerator(taskId uint64) (*models.TaskGeneratorSQL, error) {
+	var g []models.TaskGeneratorSQL
+	err := pgxscan.Select(context.Background(), td.pool, &g,
+		`SELECT * FROM task_generators WHERE task_id = $1`, taskId)
+	if err != nil {
+		log.Println("task repository: GetTaskGenerator: error getting generator", err)
+		return &models.TaskGeneratorSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if len(g) == 0 {
+		return &models.TaskGeneratorSQL{}, echo.NewHTTPError(http.StatusNotFound,
+			"Task with id "+fmt.Sprint(taskId)+" has no generator")
+	}
+
+	return &g[0], nil
+}
+
+func (td *TaskDatabase) SaveTaskGenerator(g models.TaskGeneratorSQL) error {
+	_, err := td.pool.Exec(context.Background(),
+		`INSERT INTO task_generators (task_id, generator_language, generator_code, reference_language,
+		reference_code, script) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (task_id) DO UPDATE SET generator_language = EXCLUDED.generator_language,
+		generator_code = EXCLUDED.generator_code, reference_language = EXCLUDED.reference_language,
+		reference_code = EXCLUDED.reference_code, script = EXCLUDED.script`,
+		g.TaskId, g.GeneratorLanguage, g.GeneratorCode, g.ReferenceLanguage, g.ReferenceCode, g.Script)
+	if err != nil {
+		log.Println("task repository: SaveTaskGenerator: error saving generator", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
@@ -5,193 +5,25 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"strings"
 
 	"github.com/georgysavva/scany/pgxscan"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/labstack/echo"
 
+	"liokoredu/application/blobstore"
 	"liokoredu/application/models"
 	"liokoredu/application/task"
 )
 
 type TaskDatabase struct {
-	pool *pgxpool.Pool
+	pool  *pgxpool.Pool
+	blobs blobstore.Store
 }
 
-func (td *TaskDatabase) FindTasksFull(str string, useSolved bool, solved bool, useMine bool, mine bool, uid uint64, page int, count int) (*models.ShortTasks, int, error) {
-	t := models.ShortTasks{}
-	switch {
-	case !useSolved && !useMine:
-		err := pgxscan.Select(context.Background(), td.pool, &t,
-			`SELECT distinct t.id, t.title, t.description, t.test_amount, t.creator as creator_id, u.username as creator
-			FROM tasks t
-			JOIN users u ON u.id = t.creator
-			WHERE is_private = false and (LOWER(title) LIKE '%' || $1 || '%'
-			OR LOWER(description) LIKE '%' || $1 || '%' OR to_char(t.id, '999') LIKE '%' || $1 || '%')
-			ORDER BY id DESC 
-			LIMIT $2
-			OFFSET $3`,
-			strings.ToLower(str), count, (page-1)*count)
-
-		if err != nil {
-			log.Println("task repository: findTasksFull: error getting tasks", err)
-			return &models.ShortTasks{}, 0, err
-		}
-
-		n := []int{}
-		err = pgxscan.Select(context.Background(), td.pool, &n,
-			`select count (*) 
-			from tasks t
-			JOIN users u ON u.id = t.creator
-			WHERE is_private = false and (LOWER(title) LIKE '%' || $1 || '%'
-			OR LOWER(description) LIKE '%' || $1 || '%' OR to_char(t.id, '999') LIKE '%' || $1 || '%')`,
-			strings.ToLower(str))
-
-		if err != nil {
-			log.Println("task repository: FindTasksFull: error getting num:", err)
-			return &models.ShortTasks{}, 0, err
-		}
-
-		if len(n) == 0 {
-			return &models.ShortTasks{}, 0, err
-		}
-
-		return &t, n[0], nil
-	case useSolved && !useMine:
-		s := "="
-		if !solved {
-			s = "!="
-		}
-
-		err := pgxscan.Select(context.Background(), td.pool, &t,
-			`SELECT distinct t.id, t.title, t.description, t.test_amount, t.creator as creator_id, u.username as creator
-		FROM tasks t
-		JOIN users u ON u.id = t.creator
-		JOIN tasks_done td ON td.uid = $1 and td.task_id`+s+` t.id
-		WHERE is_private = false and (LOWER(title) LIKE '%' || $1 || '%'
-		OR LOWER(description) LIKE '%' || $2 || '%' OR to_char(t.id, '999') LIKE '%' || $2 || '%')
-		ORDER BY id DESC 
-		LIMIT $3
-		OFFSET $4;`,
-			uid, str, count, (page-1)*count)
-
-		if err != nil {
-			log.Println("task repository: findTasksFull: error getting tasks", err)
-			return &models.ShortTasks{}, 0, err
-		}
-
-		n := []int{}
-		err = pgxscan.Select(context.Background(), td.pool, &n,
-			`select count (*)
-			FROM tasks t
-			JOIN users u ON u.id = t.creator
-			JOIN tasks_done td ON td.uid = $1 and td.task_id `+s+` t.id
-			WHERE is_private = false and (LOWER(title) LIKE '%' || $2 || '%'
-			OR LOWER(description) LIKE '%' || $2 || '%' OR to_char(t.id, '999') LIKE '%' || $2 || '%')
-			;`,
-			uid, str)
-
-		if err != nil {
-			log.Println("task repository: FindTasksFull: error getting num:", err)
-			return &models.ShortTasks{}, 0, err
-		}
-
-		if len(n) == 0 {
-			return &models.ShortTasks{}, 0, err
-		}
-
-		return &t, n[0], nil
-
-	case !useSolved && useMine:
-		err := pgxscan.Select(context.Background(), td.pool, &t,
-			`SELECT distinct t.id, t.title, t.description, t.test_amount, t.creator as creator_id, u.username AS creator
-			FROM tasks t
- 			JOIN users u ON u.id = t.creator
-			WHERE is_private = false AND t.creator = $1 and (LOWER(title) LIKE '%' || $2 || '%'
-			OR LOWER(description) LIKE '%' || $2 || '%' OR to_char(t.id, '999') LIKE '%' || $2 || '%')
-			ORDER BY id DESC 
-			LIMIT $3 
-			OFFSET $4`,
-			uid, str, count, (page-1)*count)
-		if err != nil {
-			log.Println("task repository: getTasks: error getting tasks", err)
-			return &models.ShortTasks{}, 0, err
-		}
-
-		n := []int{}
-		err = pgxscan.Select(context.Background(), td.pool, &n,
-			`select count (*) 
-				FROM tasks t
-				JOIN users u ON u.id = t.creator
-				WHERE is_private = false AND t.creator = $1 and (LOWER(title) LIKE '%' || $2 || '%'
-				OR LOWER(description) LIKE '%' || $2 || '%' OR to_char(t.id, '999') LIKE '%' || $2 || '%')
-				`,
-			uid, str)
-
-		if err != nil {
-			log.Println("task repository: FindTasksFull: error getting num:", err)
-			return &models.ShortTasks{}, 0, err
-		}
-
-		if len(n) == 0 {
-			return &models.ShortTasks{}, 0, err
-		}
-
-		return &t, n[0], nil
-	case useSolved && useMine:
-		s := "="
-		if !solved {
-			s = "!="
-		}
-
-		err := pgxscan.Select(context.Background(), td.pool, &t,
-			`SELECT distinct t.id, t.title, t.description, t.test_amount, t.creator as creator_id, u.username as creator
-		FROM tasks t
-		JOIN users u ON u.id = t.creator
-		JOIN tasks_done td ON td.uid = $1 and td.task_id`+s+` t.id
-		WHERE is_private = false and t.creator = $2 (LOWER(title) LIKE '%' || $3 || '%'
-		OR LOWER(description) LIKE '%' || $3 || '%' OR to_char(t.id, '999') LIKE '%' || $3 || '%')
-		ORDER BY id DESC 
-		LIMIT $4
-		OFFSET $5;`,
-			uid, uid, str, count, (page-1)*count)
-
-		if err != nil {
-			log.Println("task repository: findTasksFull: error getting tasks", err)
-			return &models.ShortTasks{}, 0, err
-		}
-
-		n := []int{}
-		err = pgxscan.Select(context.Background(), td.pool, &n,
-			`select count (*) 
-			FROM tasks t
-			JOIN users u ON u.id = t.creator
-			JOIN tasks_done td ON td.uid = $1 and td.task_id `+s+` t.id
-			WHERE is_private = false and t.creator = $2 (LOWER(title) LIKE '%' || $3 || '%'
-			OR LOWER(description) LIKE '%' || $3 || '%' OR to_char(t.id, '999') LIKE '%' || $3 || '%')
-			;`,
-			uid, uid, str)
-
-		if err != nil {
-			log.Println("task repository: FindTasksFull: error getting num:", err)
-			return &models.ShortTasks{}, 0, err
-		}
-
-		if len(n) == 0 {
-			return &models.ShortTasks{}, 0, err
-		}
-
-		return &t, n[0], nil
-	}
-
-	return &models.ShortTasks{}, 0, nil
-}
-
-func (td *TaskDatabase) GetPages() (int, error) {
+func (td *TaskDatabase) GetPages(uid uint64) (int, error) {
 	n := []int{}
 	err := pgxscan.Select(context.Background(), td.pool, &n,
-		`select count (*) from tasks;`)
+		`SELECT count(*) FROM tasks t WHERE `+visibleTask("$1"), uid)
 
 	if err != nil {
 		log.Println("task repository: GetPages: error getting num:", err)
@@ -205,34 +37,14 @@ func (td *TaskDatabase) GetPages() (int, error) {
 	return n[0], nil
 }
 
-func (td *TaskDatabase) FindTasks(str string, page int, count int) (*models.ShortTasks, error) {
-	t := models.ShortTasks{}
-	err := pgxscan.Select(context.Background(), td.pool, &t,
-		`SELECT distinct t.id, t.title, t.description, t.test_amount, t.creator as creator_id, u.username as creator
-			FROM tasks t
-			JOIN users u ON u.id = t.creator
-			WHERE is_private = false and (LOWER(title) LIKE '%' || $1 || '%'
-			OR LOWER(description) LIKE '%' || $1 || '%' OR to_char(t.id, '999') LIKE '%' || $1 || '%')
-			ORDER BY id DESC 
-			LIMIT $2
-			OFFSET $3`,
-		strings.ToLower(str), count, (page-1)*count)
-	if err != nil {
-		log.Println("task repository: findTasks: error getting tasks", err)
-		return &models.ShortTasks{}, err
-	}
-
-	return &t, nil
-}
-
 func (td *TaskDatabase) GetSolvedTasks(uid uint64, page int, count int) (*models.ShortTasks, error) {
 	t := models.ShortTasks{}
 	err := pgxscan.Select(context.Background(), td.pool, &t,
-		`SELECT distinct t.id, t.title, t.description, t.test_amount, t.creator as creator_id, u.username as creator
+		`SELECT distinct `+shortTaskColumns+`
 		FROM tasks t
 		JOIN users u ON t.creator = u.id
-		JOIN tasks_done td ON td.uid = $1 and td.task_id = t.id
-		WHERE is_private = false
+		JOIN tasks_done td ON td.uid = $1 and td.task_id = t.id`+userTaskJoins("$1")+`
+		WHERE `+visibleTask("$1")+`
 		ORDER BY t.id DESC LIMIT $2 OFFSET $3`,
 		uid, count, (page-1)*count)
 	if err != nil {
@@ -246,15 +58,15 @@ func (td *TaskDatabase) GetSolvedTasks(uid uint64, page int, count int) (*models
 func (td *TaskDatabase) GetUnsolvedTasks(uid uint64, page int, count int) (*models.ShortTasks, error) {
 	t := models.ShortTasks{}
 	err := pgxscan.Select(context.Background(), td.pool, &t,
-		`SELECT distinct t.id, t.title, t.description, t.test_amount, t.creator as creator_id, u.username as creator
+		`SELECT distinct `+shortTaskColumns+`
 		FROM tasks t
-		JOIN users u ON t.creator = u.id
-		JOIN tasks_done td ON td.uid = $1 and td.task_id != t.id
-		WHERE is_private = false
+		JOIN users u ON t.creator = u.id`+userTaskJoins("$1")+`
+		WHERE NOT EXISTS (SELECT 1 FROM tasks_done td WHERE td.uid = $1 AND td.task_id = t.id)
+		AND `+visibleTask("$1")+`
 		ORDER BY t.id DESC LIMIT $2 OFFSET $3`,
 		uid, count, (page-1)*count)
 	if err != nil {
-		log.Println("task repository: getSolvedTasks: error getting tasks", err)
+		log.Println("task repository: getUnsolvedTasks: error getting tasks", err)
 		return &models.ShortTasks{}, err
 	}
 
@@ -278,6 +90,24 @@ func (td *TaskDatabase) IsCleared(taskId uint64, uid uint64) (bool, error) {
 	return true, nil
 }
 
+// GetBestScore is the best score of the user for the task, zero if the
+// user has no checked solutions.
+func (td *TaskDatabase) GetBestScore(taskId uint64, uid uint64) (int, error) {
+	var score []int
+	err := pgxscan.Select(context.Background(), td.pool, &score,
+		`SELECT score FROM task_scores WHERE uid = $1 and task_id = $2`, uid, taskId)
+	if err != nil {
+		log.Println("task repository: GetBestScore: error getting score", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if len(score) == 0 {
+		return 0, nil
+	}
+
+	return score[0], nil
+}
+
 func (td *TaskDatabase) MarkTaskDone(id uint64, uid uint64) error {
 	_, err := td.pool.Exec(context.Background(),
 		`INSERT INTO tasks_done (uid, task_id) VALUES ($1, $2);`, uid, id)
@@ -290,12 +120,53 @@ func (td *TaskDatabase) MarkTaskDone(id uint64, uid uint64) error {
 	return nil
 }
 
+func (td *TaskDatabase) UnmarkTaskDone(id uint64, uid uint64) error {
+	_, err := td.pool.Exec(context.Background(),
+		`DELETE FROM tasks_done WHERE uid = $1 AND task_id = $2;`, uid, id)
+
+	if err != nil {
+		log.Println("task repository: UnmarkTaskDone: error deleting task_done:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (td *TaskDatabase) CanManageTask(id uint64, uid uint64) (bool, error) {
+	var allowed bool
+	err := td.pool.QueryRow(context.Background(),
+		`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = $1 AND creator = $2)
+			OR EXISTS (SELECT 1 FROM users WHERE id = $2 AND is_admin = true)`,
+		id, uid).Scan(&allowed)
+
+	if err != nil {
+		log.Println("task repository: CanManageTask: error checking rights:", err)
+		return false, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return allowed, nil
+}
+
+// UpdateTask implements task.Repository
+// Every update makes a new revision of the task.
 func (td *TaskDatabase) UpdateTask(t *models.TaskSQL) error {
-	resp, err := td.pool.Exec(context.Background(),
-		`UPDATE tasks set title = $1, description = $2, hints = $3, 
-		input = $4, output = $5, test_amount = $6, tests = $7 WHERE creator = $8 AND id = $9;`,
-		t.Title, t.Description, t.Hints, t.Input, t.Output, t.TestAmount, t.Tests, t.Creator,
-		t.Id)
+	tx, err := td.pool.Begin(context.Background())
+	if err != nil {
+		log.Println("task repository: UpdateTask: error starting transaction:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer tx.Rollback(context.Background())
+
+	resp, err := tx.Exec(context.Background(),
+		`UPDATE tasks set title = $1, description = $2, hints = $3, input = $4, output = $5,
+		test_amount = CASE WHEN tests_key = '' THEN $6 ELSE test_amount END,
+		tests = CASE WHEN tests_key = '' THEN $7 ELSE tests END, checker = $8,
+		time_limit = $9, memory_limit = $10, output_limit = $11, allowed_languages = $12,
+		subtasks = $13, max_score = $14, difficulty = $15, revision = revision + 1
+		WHERE creator = $16 AND id = $17;`,
+		t.Title, t.Description, t.Hints, t.Input, t.Output, t.TestAmount, t.Tests, t.Checker,
+		t.TimeLimit, t.MemoryLimit, t.OutputLimit, t.AllowedLanguages, t.Subtasks, t.MaxScore, t.Difficulty,
+		t.Creator, t.Id)
 
 	if err != nil {
 		log.Println("task repository: UpdateTask: error updating task:", err)
@@ -306,6 +177,15 @@ func (td *TaskDatabase) UpdateTask(t *models.TaskSQL) error {
 		return echo.NewHTTPError(http.StatusNotFound, "no task from user")
 	}
 
+	if err = saveRevision(tx, t.Id, t.Creator); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("task repository: UpdateTask: error committing:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
 	return nil
 }
 
@@ -327,17 +207,17 @@ func (td *TaskDatabase) DeleteTask(id uint64, uid uint64) error {
 	return nil
 }
 
-func (td *TaskDatabase) GetTasks(page int, count int) (*models.ShortTasks, error) {
+func (td *TaskDatabase) GetTasks(uid uint64, page int, count int) (*models.ShortTasks, error) {
 	t := models.ShortTasks{}
 	err := pgxscan.Select(context.Background(), td.pool, &t,
-		`SELECT t.id, t.title, t.description, t.test_amount, t.creator as creator_id, u.username as creator
+		`SELECT `+shortTaskColumns+`
 			FROM tasks t
-			JOIN users u ON u.id = t.creator
-			WHERE is_private = false 
-			ORDER BY id DESC 
-			LIMIT $1 
-			OFFSET $2`,
-		count, (page-1)*count)
+			JOIN users u ON u.id = t.creator`+userTaskJoins("$1")+`
+			WHERE `+visibleTask("$1")+`
+			ORDER BY t.id DESC 
+			LIMIT $2 
+			OFFSET $3`,
+		uid, count, (page-1)*count)
 	if err != nil {
 		log.Println("task repository: getTasks: error getting tasks", err)
 		return &models.ShortTasks{}, err
@@ -349,11 +229,11 @@ func (td *TaskDatabase) GetTasks(page int, count int) (*models.ShortTasks, error
 func (td *TaskDatabase) GetUserTasks(uid uint64, page int, count int) (*models.ShortTasks, error) {
 	t := models.ShortTasks{}
 	err := pgxscan.Select(context.Background(), td.pool, &t,
-		`SELECT t.id, t.title, t.description, t.test_amount, t.creator as creator_id, u.username AS creator
+		`SELECT `+shortTaskColumns+`
 			FROM tasks t
- 			JOIN users u ON u.id = t.creator
-			WHERE is_private = false AND t.creator = $1 
-			ORDER BY id DESC 
+ 			JOIN users u ON u.id = t.creator`+userTaskJoins("$1")+`
+			WHERE t.creator = $1 
+			ORDER BY t.id DESC 
 			LIMIT $2 
 			OFFSET $3`,
 		uid, count, (page-1)*count)
@@ -366,30 +246,48 @@ func (td *TaskDatabase) GetUserTasks(uid uint64, page int, count int) (*models.S
 }
 
 func (td *TaskDatabase) CreateTask(t *models.TaskSQL) (uint64, error) {
+	tx, err := td.pool.Begin(context.Background())
+	if err != nil {
+		log.Println("task repository: createTask: error starting transaction:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer tx.Rollback(context.Background())
+
 	var id uint64
-	err := td.pool.QueryRow(context.Background(),
-		`INSERT INTO tasks (title, description, hints, input, output, test_amount, tests, creator,
-				is_private, code, date) 
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id`,
-		t.Title, t.Description, t.Hints, t.Input, t.Output, t.TestAmount, t.Tests, t.Creator,
-		t.IsPrivate, t.Code, t.Date).Scan(&id)
+	err = tx.QueryRow(context.Background(),
+		`INSERT INTO tasks (title, description, hints, input, output, test_amount, tests, checker,
+				time_limit, memory_limit, output_limit, allowed_languages, subtasks, max_score, difficulty,
+				creator, is_private, code, date) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19) RETURNING id`,
+		t.Title, t.Description, t.Hints, t.Input, t.Output, t.TestAmount, t.Tests, t.Checker,
+		t.TimeLimit, t.MemoryLimit, t.OutputLimit, t.AllowedLanguages, t.Subtasks, t.MaxScore, t.Difficulty,
+		t.Creator, t.IsPrivate, t.Code, t.Date).Scan(&id)
 
 	if err != nil {
 		log.Println("task repository: createTask: error creating task:", err)
 		return 0, echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
+	if err = saveRevision(tx, id, t.Creator); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("task repository: createTask: error committing:", err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
 	return id, nil
 }
 
-func NewTaskDatabase(conn *pgxpool.Pool) task.Repository {
-	return &TaskDatabase{pool: conn}
+func NewTaskDatabase(conn *pgxpool.Pool, blobs blobstore.Store) task.Repository {
+	return &TaskDatabase{pool: conn, blobs: blobs}
 }
 
 func (td TaskDatabase) GetTask(id uint64) (*models.TaskSQL, error) {
 	var t []models.TaskSQL
 	err := pgxscan.Select(context.Background(), td.pool, &t,
-		`SELECT * FROM tasks WHERE id = $1`, id)
+		`SELECT `+taskColumns+` FROM tasks WHERE id = $1`, id)
 	if err != nil {
 		log.Println("task repository: getTask: error getting task", err)
 		return &models.TaskSQL{}, err
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"liokoredu/application/models"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/labstack/echo"
+)
+
+// revisionColumns are the columns a revision copies from the task.
+const revisionColumns = `title, description, hints, input, output, test_amount, tests, tests_key,
+	subtasks, max_score, difficulty, checker, time_limit, memory_limit, output_limit, allowed_languages`
+
+// testColumns are the columns a revision copies from stored tests.
+const testColumns = `test_num, input_key, output_key, input_size, output_size, sample, hidden, note`
+
+// saveRevision copies the current state of the task with its stored tests
+// into a new revision, it must follow every change of the task.
+func saveRevision(tx pgx.Tx, taskId uint64, author uint64) error {
+	_, err := tx.Exec(context.Background(),
+		`WITH r AS (
+			INSERT INTO task_revisions (task_id, revision, author, date, `+revisionColumns+`)
+			SELECT id, revision, $2, now(), `+revisionColumns+` FROM tasks WHERE id = $1
+			RETURNING id
+		)
+		INSERT INTO task_revision_tests (revision_id, `+testColumns+`)
+		SELECT r.id, `+testColumns+` FROM r, task_tests WHERE task_id = $1`,
+		taskId, author)
+	if err != nil {
+		log.Println("task repository: saveRevision: error saving revision:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (td *TaskDatabase) GetTaskRevisions(taskId uint64) (models.TaskRevisionsSQL, error) {
+	revs := models.TaskRevisionsSQL{}
+	err := pgxscan.Select(context.Background(), td.pool, &revs,
+		`SELECT r.revision, r.title, COALESCE(r.author, 0) AS author_id, COALESCE(u.username, '') AS author, r.date
+		FROM task_revisions r
+		LEFT JOIN users u ON u.id = r.author
+		WHERE r.task_id = $1 ORDER BY r.revision DESC`, taskId)
+	if err != nil {
+		log.Println("task repository: GetTaskRevisions: error getting revisions:", err)
+		return models.TaskRevisionsSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return revs, nil
+}
+
+// GetTaskRevision implements task.Repository
+// The revision is returned as a task with the stored tests it had.
+func (td *TaskDatabase) GetTaskRevision(taskId uint64, revision int) (*models.TaskSQL, models.TaskTestsSQL, error) {
+	var t []models.TaskSQL
+	err := pgxscan.Select(context.Background(), td.pool, &t,
+		`SELECT r.task_id AS id, r.revision, r.date, t.creator, t.is_private, t.code, `+prefixed("r.", revisionColumns)+`
+		FROM task_revisions r JOIN tasks t ON t.id = r.task_id
+		WHERE r.task_id = $1 AND r.revision = $2`, taskId, revision)
+	if err != nil {
+		log.Println("task repository: GetTaskRevision: error getting revision:", err)
+		return &models.TaskSQL{}, models.TaskTestsSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if len(t) == 0 {
+		return &models.TaskSQL{}, models.TaskTestsSQL{},
+			echo.NewHTTPError(http.StatusNotFound, "no revision "+strconv.Itoa(revision))
+	}
+
+	tests := models.TaskTestsSQL{}
+	err = pgxscan.Select(context.Background(), td.pool, &tests,
+		`SELECT r.task_id, `+prefixed("rt.", testColumns)+`
+		FROM task_revision_tests rt JOIN task_revisions r ON r.id = rt.revision_id
+		WHERE r.task_id = $1 AND r.revision = $2 ORDER BY rt.test_num`, taskId, revision)
+	if err != nil {
+		log.Println("task repository: GetTaskRevision: error getting tests:", err)
+		return &models.TaskSQL{}, models.TaskTestsSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return &t[0], tests, nil
+}
+
+// RollbackTask implements task.Repository
+// The task gets the state of the revision as a new revision, so the
+// history is kept.
+func (td *TaskDatabase) RollbackTask(taskId uint64, revision int, uid uint64) error {
+	tx, err := td.pool.Begin(context.Background())
+	if err != nil {
+		log.Println("task repository: RollbackTask: error starting transaction:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer tx.Rollback(context.Background())
+
+	set := ""
+	for i, column := range splitColumns(revisionColumns) {
+		if i > 0 {
+			set += ", "
+		}
+		set += column + " = r." + column
+	}
+	resp, err := tx.Exec(context.Background(),
+		`UPDATE tasks t SET `+set+`, revision = t.revision + 1
+		FROM task_revisions r WHERE t.id = $1 AND r.task_id = t.id AND r.revision = $2`,
+		taskId, revision)
+	if err != nil {
+		log.Println("task repository: RollbackTask: error restoring task:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	if resp.RowsAffected() == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "no revision "+strconv.Itoa(revision))
+	}
+
+	_, err = tx.Exec(context.Background(), `DELETE FROM task_tests WHERE task_id = $1`, taskId)
+	if err != nil {
+		log.Println("task repository: RollbackTask: error deleting tests:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	_, err = tx.Exec(context.Background(),
+		`INSERT INTO task_tests (task_id, `+testColumns+`)
+		SELECT r.task_id, `+prefixed("rt.", testColumns)+`
+		FROM task_revision_tests rt JOIN task_revisions r ON r.id = rt.revision_id
+		WHERE r.task_id = $1 AND r.revision = $2`, taskId, revision)
+	if err != nil {
+		log.Println("task repository: RollbackTask: error restoring tests:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if err = saveRevision(tx, taskId, uid); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("task repository: RollbackTask: error committing:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func splitColumns(columns string) []string {
+	res := []string{}
+	for _, c := range strings.Split(columns, ",") {
+		res = append(res, strings.TrimSpace(c))
+	}
+	return res
+}
+
+// prefixed qualifies the columns with a table alias.
+func prefixed(prefix string, columns string) string {
+	return prefix + strings.Join(splitColumns(columns), ", "+prefix)
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"liokoredu/application/blobstore"
+	"liokoredu/application/models"
+	"log"
+	"net/http"
+
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/labstack/echo"
+)
+
+// testKey is the blob key of a test file. Test blobs have their own prefix,
+// so they never share a blob with a solution.
+func testKey(data []byte) string {
+	return "test-" + blobstore.Key(data)
+}
+
+func (td *TaskDatabase) GetTaskTests(taskId uint64) (models.TaskTestsSQL, error) {
+	var tests models.TaskTestsSQL
+	err := pgxscan.Select(context.Background(), td.pool, &tests,
+		`SELECT * FROM task_tests WHERE task_id = $1 ORDER BY test_num`, taskId)
+	if err != nil {
+		log.Println("task repository: GetTaskTests: error getting tests:", err)
+		return models.TaskTestsSQL{}, echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return tests, nil
+}
+
+func (td *TaskDatabase) GetTestData(key string) (string, error) {
+	data, err := td.blobs.Get(key)
+	if err != nil {
+		log.Println("task repository: GetTestData: error reading test", key, err)
+		return "", echo.NewHTTPError(http.StatusInternalServerError, "test file is lost")
+	}
+
+	return string(data), nil
+}
+
+// ReplaceTaskTests implements task.Repository
+// Test files are put into blob storage, the task keeps the key of their
+// manifest, so changing tests makes solutions stale like inline tests do.
+func (td *TaskDatabase) ReplaceTaskTests(taskId uint64, uid uint64, tests models.TaskTests) error {
+	rows := models.TaskTestsSQL{}
+	for i, t := range tests {
+		row := models.TaskTestSQL{
+			TaskId:     taskId,
+			TestNum:    i,
+			InputKey:   testKey([]byte(t.Stdin)),
+			OutputKey:  testKey([]byte(t.Stdout)),
+			InputSize:  len(t.Stdin),
+			OutputSize: len(t.Stdout),
+			Sample:     t.Sample,
+			Hidden:     t.Hidden,
+			Note:       t.Note,
+		}
+		for key, data := range map[string]string{row.InputKey: t.Stdin, row.OutputKey: t.Stdout} {
+			exists, err := td.blobs.Exists(key)
+			if err == nil && !exists {
+				err = td.blobs.Put(key, []byte(data))
+			}
+			if err != nil {
+				log.Println("task repository: ReplaceTaskTests: error saving test:", err)
+				return echo.NewHTTPError(http.StatusInternalServerError, "unable to save tests")
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	manifest, err := json.Marshal(rows)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return td.setTaskTests(taskId, uid, rows, blobstore.Key(manifest))
+}
+
+// DropTaskTests implements task.Repository
+// The task goes back to inline tests and has none of them.
+func (td *TaskDatabase) DropTaskTests(taskId uint64, uid uint64) error {
+	return td.setTaskTests(taskId, uid, models.TaskTestsSQL{}, "")
+}
+
+// setTaskTests replaces stored tests of the task making a new revision of it.
+func (td *TaskDatabase) setTaskTests(taskId uint64, uid uint64, rows models.TaskTestsSQL, manifestKey string) error {
+	old, err := td.GetTaskTests(taskId)
+	if err != nil {
+		return err
+	}
+
+	tx, err := td.pool.Begin(context.Background())
+	if err != nil {
+		log.Println("task repository: setTaskTests: error starting transaction:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	defer tx.Rollback(context.Background())
+
+	_, err = tx.Exec(context.Background(), `DELETE FROM task_tests WHERE task_id = $1`, taskId)
+	if err != nil {
+		log.Println("task repository: setTaskTests: error deleting old tests:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	for _, r := range rows {
+		_, err = tx.Exec(context.Background(),
+			`INSERT INTO task_tests (task_id, test_num, input_key, output_key, input_size, output_size,
+			sample, hidden, note) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			taskId, r.TestNum, r.InputKey, r.OutputKey, r.InputSize, r.OutputSize, r.Sample, r.Hidden, r.Note)
+		if err != nil {
+			log.Println("task repository: setTaskTests: error inserting test:", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	_, err = tx.Exec(context.Background(),
+		`UPDATE tasks SET tests = '[]', test_amount = $1, tests_key = $2, revision = revision + 1 WHERE id = $3`,
+		len(rows), manifestKey, taskId)
+	if err != nil {
+		log.Println("task repository: setTaskTests: error updating task:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	if err = saveRevision(tx, taskId, uid); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(context.Background()); err != nil {
+		log.Println("task repository: setTaskTests: error committing:", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	td.deleteUnusedTests(old)
+
+	return nil
+}
+
+// deleteUnusedTests removes blobs of replaced tests no task or revision
+// refers to.
+// Failures only leave garbage behind, so they are logged.
+func (td *TaskDatabase) deleteUnusedTests(old models.TaskTestsSQL) {
+	keys := map[string]bool{}
+	for _, t := range old {
+		keys[t.InputKey] = true
+		keys[t.OutputKey] = true
+	}
+
+	for key := range keys {
+		var used bool
+		err := td.pool.QueryRow(context.Background(),
+			`SELECT EXISTS (SELECT 1 FROM task_tests WHERE input_key = $1 OR output_key = $1)
+			OR EXISTS (SELECT 1 FROM task_revision_tests WHERE input_key = $1 OR output_key = $1)`,
+			key).Scan(&used)
+		if err != nil {
+			log.Println("task repository: deleteUnusedTests: error checking test usage:", err)
+			continue
+		}
+		if used {
+			continue
+		}
+		if err = td.blobs.Delete(key); err != nil {
+			log.Println("task repository: deleteUnusedTests: error deleting test:", err)
+		}
+	}
+}
@@ -4,8 +4,10 @@ import "liokoredu/application/models"
 
 type UseCase interface {
 	GetTask(id uint64, uid uint64, forCheck bool) (*models.Task, error)
+	GetTaskSettings(id uint64, uid uint64) (*models.Task, error)
+	GetTaskToJudge(id uint64) (*models.Task, error)
 	GetTasks(uid uint64, page int, count int) (models.ShortTasks, error)
-	GetPages(count int) (int, error)
+	GetPages(uid uint64, count int) (int, error)
 	GetSolvedTasks(uid uint64, page int, count int) (models.ShortTasks, error)
 	GetUnsolvedTasks(uid uint64, page int, count int) (models.ShortTasks, error)
 	IsCleared(taskId uint64, uid uint64) (bool, error)
@@ -14,6 +16,26 @@ type UseCase interface {
 	DeleteTask(id uint64, uid uint64) error
 	UpdateTask(id uint64, t *models.TaskNew) error
 	MarkTaskDone(id uint64, uid uint64) error
+	UnmarkTaskDone(id uint64, uid uint64) error
+	CanManageTask(id uint64, uid uint64) (bool, error)
+	GetTaskTests(id uint64, uid uint64) (models.TaskTestInfos, error)
+	UploadTests(id uint64, uid uint64, archive []byte, samples []int, hidden []int) (models.TaskTestInfos, error)
+	DropTaskTests(id uint64, uid uint64) error
+	GetTaskGenerator(id uint64, uid uint64) (*models.TaskGenerator, error)
+	SaveTaskGenerator(id uint64, uid uint64, g *models.TaskGenerator) error
+	GenerateTests(id uint64, uid uint64) (models.TaskTestInfos, error)
 	FindTasks(str string, uid uint64, page int, count int) (models.ShortTasks, error)
-	FindTasksFull(str string, useSolved bool, solved bool, useMine bool, mine bool, uid uint64, page int, count int) (models.ShortTasks, int, error)
+	FindTasksFull(f models.TaskFilter) (models.TasksWithNum, error)
+	GetTags() (models.TagCounts, error)
+	GetTaskRevisions(id uint64, uid uint64) (models.TaskRevisions, error)
+	DiffRevisions(id uint64, uid uint64, from int, to int) (models.TaskRevisionDiff, error)
+	RollbackTask(id uint64, uid uint64, revision int) (*models.Task, error)
+	GetTaskAtRevision(id uint64, revision int) (*models.Task, error)
+	GetTaskAccess(id uint64, uid uint64) (models.TaskAccess, error)
+	SetTaskPrivate(id uint64, uid uint64, private bool) (models.TaskAccess, error)
+	RenewInvite(id uint64, uid uint64) (models.TaskAccess, error)
+	RevokeInvite(id uint64, uid uint64) (models.TaskAccess, error)
+	JoinTask(code string, uid uint64) (*models.Task, error)
+	GrantTask(id uint64, uid uint64, username string) (models.TaskAccess, error)
+	RevokeGrant(id uint64, uid uint64, grantee uint64) (models.TaskAccess, error)
 }
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"liokoredu/application/models"
+	"liokoredu/pkg/constants"
+	"liokoredu/pkg/generators"
+)
+
+func newInviteCode() string {
+	return generators.RandStringRunes(constants.PrivateLength)
+}
+
+func (uc *TaskUseCase) GetTaskAccess(id uint64, uid uint64) (models.TaskAccess, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return models.TaskAccess{}, err
+	}
+
+	return uc.repo.GetTaskAccess(id)
+}
+
+// SetTaskPrivate implements task.UseCase
+// A task made private gets an invite unless it has one.
+func (uc *TaskUseCase) SetTaskPrivate(id uint64, uid uint64, private bool) (models.TaskAccess, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return models.TaskAccess{}, err
+	}
+
+	if err := uc.repo.SetTaskPrivate(id, private, newInviteCode()); err != nil {
+		return models.TaskAccess{}, err
+	}
+
+	return uc.repo.GetTaskAccess(id)
+}
+
+// RenewInvite implements task.UseCase
+// The old invite stops working, users who have joined by it keep access.
+func (uc *TaskUseCase) RenewInvite(id uint64, uid uint64) (models.TaskAccess, error) {
+	return uc.setInviteCode(id, uid, newInviteCode())
+}
+
+func (uc *TaskUseCase) RevokeInvite(id uint64, uid uint64) (models.TaskAccess, error) {
+	return uc.setInviteCode(id, uid, "")
+}
+
+func (uc *TaskUseCase) setInviteCode(id uint64, uid uint64, code string) (models.TaskAccess, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return models.TaskAccess{}, err
+	}
+
+	if err := uc.repo.SetInviteCode(id, code); err != nil {
+		return models.TaskAccess{}, err
+	}
+
+	return uc.repo.GetTaskAccess(id)
+}
+
+// JoinTask implements task.UseCase
+// The user gets access to the private task with the invite.
+func (uc *TaskUseCase) JoinTask(code string, uid uint64) (*models.Task, error) {
+	id, err := uc.repo.JoinTask(code, uid)
+	if err != nil {
+		return &models.Task{}, err
+	}
+
+	return uc.GetTask(id, uid, false)
+}
+
+func (uc *TaskUseCase) GrantTask(id uint64, uid uint64, username string) (models.TaskAccess, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return models.TaskAccess{}, err
+	}
+
+	if err := uc.repo.GrantTask(id, username); err != nil {
+		return models.TaskAccess{}, err
+	}
+
+	return uc.repo.GetTaskAccess(id)
+}
+
+func (uc *TaskUseCase) RevokeGrant(id uint64, uid uint64, grantee uint64) (models.TaskAccess, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return models.TaskAccess{}, err
+	}
+
+	if err := uc.repo.RevokeGrant(id, grantee); err != nil {
+		return models.TaskAccess{}, err
+	}
+
+	return uc.repo.GetTaskAccess(id)
+}
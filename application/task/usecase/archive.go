@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"liokoredu/application/models"
+	"liokoredu/pkg/constants"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/labstack/echo"
+)
+
+// ParseTestsArchive reads tests from a ZIP archive of NN.in and NN.out
+// files, directories inside the archive are ignored. Tests are ordered by
+// their numbers, which don't have to be consecutive, the numbers are
+// returned along with the tests.
+func ParseTestsArchive(data []byte) (models.TaskTests, []int, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "archive is not a zip file: "+err.Error())
+	}
+
+	inputs := map[int]string{}
+	outputs := map[int]string{}
+	var unpacked uint64
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		name := path.Base(f.Name)
+		if strings.HasPrefix(name, ".") || strings.HasPrefix(f.Name, "__MACOSX/") {
+			continue
+		}
+
+		ext := path.Ext(name)
+		num, err := strconv.Atoi(strings.TrimSuffix(name, ext))
+		if err != nil || (ext != ".in" && ext != ".out") {
+			return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "unexpected file "+f.Name+", only NN.in and NN.out are allowed")
+		}
+
+		unpacked += f.UncompressedSize64
+		if unpacked > constants.TestsUnpackedSize {
+			return nil, nil, echo.NewHTTPError(http.StatusRequestEntityTooLarge, "tests are too large")
+		}
+		text, err := readZipFile(f)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		files := inputs
+		if ext == ".out" {
+			files = outputs
+		}
+		if _, ok := files[num]; ok {
+			return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "duplicate file "+f.Name)
+		}
+		files[num] = text
+	}
+
+	nums := []int{}
+	for num := range inputs {
+		if _, ok := outputs[num]; !ok {
+			return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "test "+strconv.Itoa(num)+" has no output")
+		}
+		nums = append(nums, num)
+	}
+	if len(nums) != len(outputs) {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "some outputs have no inputs")
+	}
+	if len(nums) == 0 {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "archive has no tests")
+	}
+	if len(nums) > constants.TestsMaxCount {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "too many tests")
+	}
+	sort.Ints(nums)
+
+	tests := models.TaskTests{}
+	for _, num := range nums {
+		tests = append(tests, models.TaskTest{Stdin: inputs[num], Stdout: outputs[num]})
+	}
+
+	return tests, nums, nil
+}
+
+func readZipFile(f *zip.File) (string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "broken file "+f.Name+": "+err.Error())
+	}
+	defer rc.Close()
+
+	// the declared size can't be trusted
+	data, err := ioutil.ReadAll(&io.LimitedReader{R: rc, N: int64(f.UncompressedSize64) + 1})
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "broken file "+f.Name+": "+err.Error())
+	}
+	if uint64(len(data)) > f.UncompressedSize64 {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "broken file "+f.Name)
+	}
+	if !utf8.Valid(data) {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "file "+f.Name+" is not a text")
+	}
+
+	return string(data), nil
+}
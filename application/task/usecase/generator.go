@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"liokoredu/application/language"
+	"liokoredu/application/models"
+	"liokoredu/pkg/constants"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo"
+)
+
+// Helper programs of authors may need more than solutions do.
+var generatorLimits = models.TaskLimits{
+	Time:   constants.TaskMaxTimeLimit,
+	Memory: constants.TaskMaxMemoryLimit,
+	Output: constants.TaskMaxOutputLimit,
+}
+
+func (uc *TaskUseCase) GetTaskGenerator(id uint64, uid uint64) (*models.TaskGenerator, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return nil, err
+	}
+
+	g, err := uc.repo.GetTaskGenerator(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.ConvertToGenerator(), nil
+}
+
+func (uc *TaskUseCase) SaveTaskGenerator(id uint64, uid uint64, g *models.TaskGenerator) error {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return err
+	}
+	if err := validateGenerator(g); err != nil {
+		return err
+	}
+
+	return uc.repo.SaveTaskGenerator(g.ConvertToSQL(id))
+}
+
+// GenerateTests implements task.UseCase
+// Each step of the script is run through the judge: the generator prints
+// an input, the reference solution prints the expected output. Tests are
+// replaced only if every step succeeds.
+func (uc *TaskUseCase) GenerateTests(id uint64, uid uint64) (models.TaskTestInfos, error) {
+	g, err := uc.GetTaskGenerator(id, uid)
+	if err != nil {
+		return models.TaskTestInfos{}, err
+	}
+	if err = validateGenerator(g); err != nil {
+		return models.TaskTestInfos{}, err
+	}
+	genLang, _ := language.Get(g.Generator.Language)
+	refLang, _ := language.Get(g.Reference.Language)
+
+	flagged := false
+	for _, step := range g.Script {
+		flagged = flagged || step.Sample || step.Hidden
+	}
+
+	tests := models.TaskTests{}
+	for i, step := range g.Script {
+		num := strconv.Itoa(i + 1)
+		input, err := uc.runHelper(genLang, g.Generator.SourceCode, step.Args, "generator failed on test "+num)
+		if err != nil {
+			return models.TaskTestInfos{}, err
+		}
+		output, err := uc.runHelper(refLang, g.Reference.SourceCode, input, "reference solution failed on test "+num)
+		if err != nil {
+			return models.TaskTestInfos{}, err
+		}
+
+		tests = append(tests, models.TaskTest{
+			Stdin:  input,
+			Stdout: output,
+			Sample: step.Sample || (!flagged && i < 2),
+			Hidden: step.Hidden,
+			Note:   step.Note,
+		})
+	}
+
+	if err = uc.repo.ReplaceTaskTests(id, uid, tests); err != nil {
+		return models.TaskTestInfos{}, err
+	}
+
+	return uc.GetTaskTests(id, uid)
+}
+
+func (uc *TaskUseCase) runHelper(lang models.Language, code map[string]interface{}, stdin string, failure string) (string, error) {
+	res, err := uc.checker.Run(&models.RunSend{
+		SourceCode: code,
+		Stdin:      stdin,
+		Limits:     generatorLimits,
+		Language:   lang,
+	})
+	if err != nil {
+		return "", err
+	}
+	if res.Verdict != models.VerdictAccepted {
+		return "", echo.NewHTTPError(http.StatusBadRequest, failure+": "+res.CheckMessage)
+	}
+
+	return res.Stdout, nil
+}
+
+func validateGenerator(g *models.TaskGenerator) error {
+	for _, p := range []models.Program{g.Generator, g.Reference} {
+		l, ok := language.Get(p.Language)
+		if !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "unknown language "+p.Language)
+		}
+		if err := language.CheckFiles(l, p.SourceCode); err != nil {
+			return err
+		}
+	}
+
+	if len(g.Script) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "generator script is empty")
+	}
+	if len(g.Script) > constants.TestsMaxCount {
+		return echo.NewHTTPError(http.StatusBadRequest, "too many tests")
+	}
+	for i, step := range g.Script {
+		if step.Sample && step.Hidden {
+			return echo.NewHTTPError(http.StatusBadRequest, "test "+strconv.Itoa(i+1)+" can't be a sample and hidden at once")
+		}
+		if len(step.Args) > constants.RunStdinLength {
+			return echo.NewHTTPError(http.StatusBadRequest, "arguments of test "+strconv.Itoa(i+1)+" are too long")
+		}
+	}
+
+	return nil
+}
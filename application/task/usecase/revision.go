@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"liokoredu/application/models"
+	"liokoredu/pkg/diff"
+	"strconv"
+	"strings"
+)
+
+func (uc *TaskUseCase) GetTaskRevisions(id uint64, uid uint64) (models.TaskRevisions, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return models.TaskRevisions{}, err
+	}
+
+	revs, err := uc.repo.GetTaskRevisions(id)
+	if err != nil {
+		return models.TaskRevisions{}, err
+	}
+
+	return revs.ConvertToJson(), nil
+}
+
+// DiffRevisions implements task.UseCase
+// Revisions show hidden tests and the checker, so only those who manage the
+// task can diff them.
+func (uc *TaskUseCase) DiffRevisions(id uint64, uid uint64, from int, to int) (models.TaskRevisionDiff, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return models.TaskRevisionDiff{}, err
+	}
+
+	files := []map[string]interface{}{}
+	for _, rev := range []int{from, to} {
+		t, tests, err := uc.repo.GetTaskRevision(id, rev)
+		if err != nil {
+			return models.TaskRevisionDiff{}, err
+		}
+		files = append(files, revisionFiles(t, tests))
+	}
+
+	return models.TaskRevisionDiff{
+		From:  from,
+		To:    to,
+		Files: diff.Files(files[0], files[1]),
+	}, nil
+}
+
+// RollbackTask implements task.UseCase
+// The task gets the state of the revision as a new one.
+func (uc *TaskUseCase) RollbackTask(id uint64, uid uint64, revision int) (*models.Task, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return &models.Task{}, err
+	}
+
+	if err := uc.repo.RollbackTask(id, revision, uid); err != nil {
+		return &models.Task{}, err
+	}
+
+	return uc.GetTask(id, uid, false)
+}
+
+// GetTaskAtRevision implements task.UseCase
+// The task is loaded as it was at the revision with all of its tests, so
+// solutions are shown along with the tests they were checked on. Access
+// is not checked, callers do it themselves.
+func (uc *TaskUseCase) GetTaskAtRevision(id uint64, revision int) (*models.Task, error) {
+	t, rows, err := uc.repo.GetTaskRevision(id, revision)
+	if err != nil {
+		return &models.Task{}, err
+	}
+
+	tsk := t.ConvertToTask(true, false)
+	if tsk.TestsStored {
+		tsk.Tests, err = uc.readTests(rows, true)
+		if err != nil {
+			return &models.Task{}, err
+		}
+	}
+
+	return tsk, nil
+}
+
+// revisionFiles shows every part of a task revision as a text file named
+// after its JSON field, so revisions are diffed line by line.
+func revisionFiles(t *models.TaskSQL, tests models.TaskTestsSQL) map[string]interface{} {
+	files := map[string]interface{}{
+		"name":              t.Title,
+		"description":       t.Description,
+		"hints":             t.Hints.String,
+		"stdinDescription":  t.Input,
+		"stdoutDescription": t.Output,
+		"checker":           indentJSON(t.Checker),
+		"subtasks":          indentJSON(t.Subtasks),
+		"difficulty":        strconv.Itoa(t.Difficulty) + "\n",
+		"limits": fmt.Sprintf("time: %d\nmemory: %d\noutput: %d\n",
+			t.TimeLimit, t.MemoryLimit, t.OutputLimit),
+	}
+
+	if len(t.AllowedLanguages) > 0 {
+		files["languages"] = strings.Join(t.AllowedLanguages, "\n") + "\n"
+	}
+
+	if t.TestsKey == "" {
+		files["tests"] = indentJSON(t.Tests)
+		return files
+	}
+	// stored tests are too large to diff, their keys change with contents
+	stored := ""
+	for _, test := range tests {
+		stored += fmt.Sprintf("%d: input %s (%d bytes), output %s (%d bytes)",
+			test.TestNum+1, test.InputKey, test.InputSize, test.OutputKey, test.OutputSize)
+		if test.Sample {
+			stored += ", sample"
+		}
+		if test.Hidden {
+			stored += ", hidden"
+		}
+		if test.Note != "" {
+			stored += ", note: " + strconv.Quote(test.Note)
+		}
+		stored += "\n"
+	}
+	files["tests"] = stored
+
+	return files
+}
+
+func indentJSON(s string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return buf.String() + "\n"
+}
@@ -1,34 +1,47 @@
 package usecase
 
 import (
+	"liokoredu/application/checker"
+	"liokoredu/application/language"
 	"liokoredu/application/models"
 	"liokoredu/application/task"
+	"liokoredu/pkg/constants"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo"
 )
 
 type TaskUseCase struct {
-	repo task.Repository
+	repo    task.Repository
+	checker checker.Checker
 }
 
 // FindTasksFull implements task.UseCase
-func (tuc *TaskUseCase) FindTasksFull(str string, useSolved bool, solved bool, useMine bool, mine bool, uid uint64, page int, count int) (models.ShortTasks, int, error) {
-	tsks, num, err := tuc.repo.FindTasksFull(str, useSolved, solved, useMine, mine, uid, page, count)
-	if err != nil {
-		return models.ShortTasks{}, num, err
+// Tasks come with facets counted over all of the filtered ones.
+func (tuc *TaskUseCase) FindTasksFull(f models.TaskFilter) (models.TasksWithNum, error) {
+	var ok bool
+	if f.Tags, ok = models.NormalizeTags(f.Tags); !ok {
+		return models.TasksWithNum{}, echo.NewHTTPError(http.StatusBadRequest, "invalid tags")
 	}
-	if uid == 0 {
-		return *tsks, num, nil
+	if f.ExcludeTags, ok = models.NormalizeTags(f.ExcludeTags); !ok {
+		return models.TasksWithNum{}, echo.NewHTTPError(http.StatusBadRequest, "invalid tags")
 	}
-	tsksArr := models.ShortTasks{}
-	for _, tsk := range *tsks {
-		isDone, err := tuc.repo.IsCleared(tsk.Id, uid)
-		if err != nil {
-			return models.ShortTasks{}, num, err
-		}
-		tsk.IsCleared = isDone
-		tsksArr = append(tsksArr, tsk)
+
+	tsks, num, err := tuc.repo.FindTasksFull(f)
+	if err != nil {
+		return models.TasksWithNum{}, err
 	}
+	facets, err := tuc.repo.GetTaskFacets(f)
+	if err != nil {
+		return models.TasksWithNum{}, err
+	}
+
+	return models.TasksWithNum{Tsks: *tsks, Num: num, Facets: &facets}, nil
+}
 
-	return tsksArr, num, nil
+func (tuc *TaskUseCase) GetTags() (models.TagCounts, error) {
+	return tuc.repo.GetTags()
 }
 
 func (tuc *TaskUseCase) IsCleared(taskId uint64, uid uint64) (bool, error) {
@@ -46,10 +59,44 @@ func (tuc *TaskUseCase) MarkTaskDone(id uint64, uid uint64) error {
 	return tuc.repo.MarkTaskDone(id, uid)
 }
 
+func (tuc *TaskUseCase) UnmarkTaskDone(id uint64, uid uint64) error {
+	return tuc.repo.UnmarkTaskDone(id, uid)
+}
+
+// CanManageTask implements task.UseCase
+// Only the creator of a task and admins are allowed to manage it.
+func (tuc *TaskUseCase) CanManageTask(id uint64, uid uint64) (bool, error) {
+	if uid == 0 {
+		return false, nil
+	}
+	return tuc.repo.CanManageTask(id, uid)
+}
+
+// checkCanManage is CanManageTask turned into an error for the others.
+func (tuc *TaskUseCase) checkCanManage(id uint64, uid uint64) error {
+	can, err := tuc.CanManageTask(id, uid)
+	if err != nil {
+		return err
+	}
+	if !can {
+		return echo.NewHTTPError(http.StatusForbidden, "only the author can manage the task")
+	}
+	return nil
+}
+
 func (tuc *TaskUseCase) UpdateTask(id uint64, t *models.TaskNew) error {
+	if err := validateJudging(t); err != nil {
+		return err
+	}
+	if err := validateCatalog(t); err != nil {
+		return err
+	}
 	tsk := t.ConvertNewTaskToTaskSQL()
 	tsk.Id = id
-	return tuc.repo.UpdateTask(tsk)
+	if err := tuc.repo.UpdateTask(tsk); err != nil {
+		return err
+	}
+	return tuc.repo.SetTaskTags(id, t.Tags)
 }
 
 func (tuc *TaskUseCase) DeleteTask(id uint64, uid uint64) error {
@@ -57,45 +104,21 @@ func (tuc *TaskUseCase) DeleteTask(id uint64, uid uint64) error {
 }
 
 func (tuc *TaskUseCase) FindTasks(str string, uid uint64, page int, count int) (models.ShortTasks, error) {
-	tsks, err := tuc.repo.FindTasks(str, page, count)
+	tsks, err := tuc.repo.FindTasks(str, uid, page, count)
 	if err != nil {
 		return models.ShortTasks{}, err
 	}
-	if uid == 0 {
-		return *tsks, nil
-	}
-	tsksArr := models.ShortTasks{}
-	for _, tsk := range *tsks {
-		isDone, err := tuc.repo.IsCleared(tsk.Id, uid)
-		if err != nil {
-			return models.ShortTasks{}, err
-		}
-		tsk.IsCleared = isDone
-		tsksArr = append(tsksArr, tsk)
-	}
 
-	return tsksArr, nil
+	return *tsks, nil
 }
 
 func (tuc *TaskUseCase) GetTasks(uid uint64, page int, count int) (models.ShortTasks, error) {
-	tsks, err := tuc.repo.GetTasks(page, count)
+	tsks, err := tuc.repo.GetTasks(uid, page, count)
 	if err != nil {
 		return models.ShortTasks{}, err
 	}
-	if uid == 0 {
-		return *tsks, nil
-	}
-	tsksArr := models.ShortTasks{}
-	for _, tsk := range *tsks {
-		isDone, err := tuc.repo.IsCleared(tsk.Id, uid)
-		if err != nil {
-			return models.ShortTasks{}, err
-		}
-		tsk.IsCleared = isDone
-		tsksArr = append(tsksArr, tsk)
-	}
 
-	return tsksArr, nil
+	return *tsks, nil
 }
 
 func (tuc *TaskUseCase) GetSolvedTasks(uid uint64, page int, count int) (models.ShortTasks, error) {
@@ -103,16 +126,8 @@ func (tuc *TaskUseCase) GetSolvedTasks(uid uint64, page int, count int) (models.
 	if err != nil {
 		return models.ShortTasks{}, err
 	}
-	if uid == 0 {
-		return *tsks, nil
-	}
-	tsksArr := models.ShortTasks{}
-	for _, tsk := range *tsks {
-		tsk.IsCleared = true
-		tsksArr = append(tsksArr, tsk)
-	}
 
-	return tsksArr, nil
+	return *tsks, nil
 }
 
 func (tuc *TaskUseCase) GetUnsolvedTasks(uid uint64, page int, count int) (models.ShortTasks, error) {
@@ -120,16 +135,8 @@ func (tuc *TaskUseCase) GetUnsolvedTasks(uid uint64, page int, count int) (model
 	if err != nil {
 		return models.ShortTasks{}, err
 	}
-	if uid == 0 {
-		return *tsks, nil
-	}
-	tsksArr := models.ShortTasks{}
-	for _, tsk := range *tsks {
-		tsk.IsCleared = false
-		tsksArr = append(tsksArr, tsk)
-	}
 
-	return tsksArr, nil
+	return *tsks, nil
 }
 
 func (uc *TaskUseCase) GetUserTasks(uid uint64, page int, count int) (models.ShortTasks, error) {
@@ -142,15 +149,96 @@ func (uc *TaskUseCase) GetUserTasks(uid uint64, page int, count int) (models.Sho
 }
 
 func (uc *TaskUseCase) CreateTask(t *models.TaskNew) (uint64, error) {
-	return uc.repo.CreateTask(t.ConvertNewTaskToTaskSQL())
+	if err := validateJudging(t); err != nil {
+		return 0, err
+	}
+	if err := validateCatalog(t); err != nil {
+		return 0, err
+	}
+	// invites are issued by the server only
+	t.Code = ""
+	if t.IsPrivate {
+		t.Code = newInviteCode()
+	}
+	id, err := uc.repo.CreateTask(t.ConvertNewTaskToTaskSQL())
+	if err != nil {
+		return 0, err
+	}
+	return id, uc.repo.SetTaskTags(id, t.Tags)
 }
 
-func NewTaskUseCase(t task.Repository) task.UseCase {
-	return &TaskUseCase{repo: t}
+// validateCatalog normalizes tags of the task and checks its difficulty.
+func validateCatalog(t *models.TaskNew) error {
+	tags, ok := models.NormalizeTags(t.Tags)
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid tags")
+	}
+	if len(tags) > constants.TaskMaxTags {
+		return echo.NewHTTPError(http.StatusBadRequest, "too many tags")
+	}
+	t.Tags = tags
+	if t.Difficulty != 0 && (t.Difficulty < constants.MinDifficulty || t.Difficulty > constants.MaxDifficulty) {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid difficulty")
+	}
+
+	return nil
 }
 
-func (uc TaskUseCase) GetTask(id uint64, uid uint64, forCheck bool) (*models.Task, error) {
+// validateJudging checks the settings the judge relies on.
+func validateJudging(t *models.TaskNew) error {
+	if t.Checker.Mode != "" && !t.Checker.Validate() {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid checker")
+	}
+	if t.Checker.Language != "" {
+		if _, ok := language.Get(t.Checker.Language); !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "unknown checker language "+t.Checker.Language)
+		}
+	}
+	if !t.Limits.Validate() {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid limits")
+	}
+	if !t.Tests.Validate() {
+		return echo.NewHTTPError(http.StatusBadRequest, "test can't be a sample and hidden at once")
+	}
+	if !t.Subtasks.Validate() {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid subtasks")
+	}
+	for _, id := range t.Languages {
+		if _, ok := language.Get(id); !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, "unknown language "+id)
+		}
+	}
+
+	return nil
+}
+
+func NewTaskUseCase(t task.Repository, c checker.Checker) task.UseCase {
+	return &TaskUseCase{repo: t, checker: c}
+}
+
+// viewTask loads the task if the user may see it, a private task is
+// reported as missing to everyone else.
+func (uc TaskUseCase) viewTask(id uint64, uid uint64) (*models.TaskSQL, error) {
 	t, err := uc.repo.GetTask(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.IsPrivate && t.Creator != uid {
+		can, err := uc.repo.CanViewTask(id, uid)
+		if err != nil {
+			return nil, err
+		}
+		if !can {
+			return nil, echo.NewHTTPError(http.StatusNotFound, "Task with id "+strconv.FormatUint(id, 10)+" not found")
+		}
+	}
+
+	return t, nil
+}
+
+func (uc TaskUseCase) GetTask(id uint64, uid uint64, forCheck bool) (*models.Task, error) {
+	t, err := uc.viewTask(id, uid)
 	if err != nil {
 		return &models.Task{}, err
 	}
@@ -166,11 +254,55 @@ func (uc TaskUseCase) GetTask(id uint64, uid uint64, forCheck bool) (*models.Tas
 	}
 
 	tsk := t.ConvertToTask(isCreator, isCleared)
+	tsk.Tags, err = uc.repo.GetTaskTags(id)
+	if err != nil {
+		return &models.Task{}, err
+	}
+	tsk.Score, err = uc.repo.GetBestScore(id, uid)
+	if err != nil {
+		return &models.Task{}, err
+	}
+	if tsk.TestsStored {
+		tsk.Tests, err = uc.loadStoredTests(id, forCheck)
+		if err != nil {
+			return &models.Task{}, err
+		}
+	}
+	return tsk, nil
+}
+
+// GetTaskSettings returns languages and limits of the task the user may see,
+// tests kept in blob storage are not loaded.
+func (uc TaskUseCase) GetTaskSettings(id uint64, uid uint64) (*models.Task, error) {
+	t, err := uc.viewTask(id, uid)
+	if err != nil {
+		return &models.Task{}, err
+	}
+
+	return t.ConvertToTask(false, false), nil
+}
+
+// GetTaskToJudge returns the task with all of its tests and the checker.
+// Access is not checked, a solution once accepted for judging is judged
+// even if its author has lost access to the task since.
+func (uc TaskUseCase) GetTaskToJudge(id uint64) (*models.Task, error) {
+	t, err := uc.repo.GetTask(id)
+	if err != nil {
+		return &models.Task{}, err
+	}
+
+	tsk := t.ConvertToTask(true, false)
+	if tsk.TestsStored {
+		tsk.Tests, err = uc.loadStoredTests(id, true)
+		if err != nil {
+			return &models.Task{}, err
+		}
+	}
 	return tsk, nil
 }
 
-func (uc TaskUseCase) GetPages(count int) (int, error) {
-	n, err := uc.repo.GetPages()
+func (uc TaskUseCase) GetPages(uid uint64, count int) (int, error) {
+	n, err := uc.repo.GetPages(uid)
 	if err != nil {
 		return 0, err
 	}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"liokoredu/application/models"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo"
+)
+
+func (uc *TaskUseCase) GetTaskTests(id uint64, uid uint64) (models.TaskTestInfos, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return models.TaskTestInfos{}, err
+	}
+
+	tests, err := uc.repo.GetTaskTests(id)
+	if err != nil {
+		return models.TaskTestInfos{}, err
+	}
+
+	return tests.ConvertToInfo(), nil
+}
+
+// UploadTests replaces tests of the task with the ones from the archive.
+// samples and hidden are the NN numbers of test files in the archive, if
+// none of them are given the first two tests become samples.
+func (uc *TaskUseCase) UploadTests(id uint64, uid uint64, archive []byte, samples []int, hidden []int) (models.TaskTestInfos, error) {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return models.TaskTestInfos{}, err
+	}
+
+	tests, nums, err := ParseTestsArchive(archive)
+	if err != nil {
+		return models.TaskTestInfos{}, err
+	}
+
+	if len(samples) == 0 && len(hidden) == 0 {
+		for i := 0; i < len(tests) && i < 2; i++ {
+			tests[i].Sample = true
+		}
+	}
+	index := map[int]int{}
+	for i, num := range nums {
+		index[num] = i
+	}
+	for _, num := range samples {
+		i, ok := index[num]
+		if !ok {
+			return models.TaskTestInfos{}, echo.NewHTTPError(http.StatusBadRequest, "no test "+strconv.Itoa(num))
+		}
+		tests[i].Sample = true
+	}
+	for _, num := range hidden {
+		i, ok := index[num]
+		if !ok {
+			return models.TaskTestInfos{}, echo.NewHTTPError(http.StatusBadRequest, "no test "+strconv.Itoa(num))
+		}
+		tests[i].Hidden = true
+	}
+	if !tests.Validate() {
+		return models.TaskTestInfos{}, echo.NewHTTPError(http.StatusBadRequest, "test can't be a sample and hidden at once")
+	}
+
+	if err = uc.repo.ReplaceTaskTests(id, uid, tests); err != nil {
+		return models.TaskTestInfos{}, err
+	}
+
+	return uc.GetTaskTests(id, uid)
+}
+
+func (uc *TaskUseCase) DropTaskTests(id uint64, uid uint64) error {
+	if err := uc.checkCanManage(id, uid); err != nil {
+		return err
+	}
+
+	return uc.repo.DropTaskTests(id, uid)
+}
+
+// loadStoredTests reads tests kept in blob storage. The judge gets all of
+// them, everyone else only needs the samples shown in the statement.
+func (uc TaskUseCase) loadStoredTests(id uint64, all bool) (models.TaskTests, error) {
+	rows, err := uc.repo.GetTaskTests(id)
+	if err != nil {
+		return models.TaskTests{}, err
+	}
+
+	return uc.readTests(rows, all)
+}
+
+func (uc TaskUseCase) readTests(rows models.TaskTestsSQL, all bool) (models.TaskTests, error) {
+	var err error
+	tests := models.TaskTests{}
+	for _, r := range rows {
+		t := models.TaskTest{Sample: r.Sample, Hidden: r.Hidden, Note: r.Note}
+		if !all && !(t.Sample && !t.Hidden) {
+			continue
+		}
+		if t.Stdin, err = uc.repo.GetTestData(r.InputKey); err != nil {
+			return models.TaskTests{}, err
+		}
+		if t.Stdout, err = uc.repo.GetTestData(r.OutputKey); err != nil {
+			return models.TaskTests{}, err
+		}
+		tests = append(tests, t)
+	}
+
+	return tests, nil
+}
@@ -0,0 +1,254 @@
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"liokoredu/application/checker"
+	"liokoredu/application/models"
+	"liokoredu/application/task"
+	"liokoredu/application/task/usecase"
+)
+
+type taskRepo struct {
+	task.Repository
+	generator models.TaskGeneratorSQL
+	tests     models.TaskTests
+	revisions map[int]models.TaskSQL
+	private   models.TaskSQL
+	grants    map[uint64]bool
+}
+
+func (tr *taskRepo) CanManageTask(id uint64, uid uint64) (bool, error) {
+	return uid == 1, nil
+}
+
+func (tr *taskRepo) GetTaskGenerator(taskId uint64) (*models.TaskGeneratorSQL, error) {
+	return &tr.generator, nil
+}
+
+func (tr *taskRepo) ReplaceTaskTests(taskId uint64, uid uint64, tests models.TaskTests) error {
+	tr.tests = tests
+	return nil
+}
+
+func (tr *taskRepo) GetTaskTests(taskId uint64) (models.TaskTestsSQL, error) {
+	return models.TaskTestsSQL{}, nil
+}
+
+func (tr *taskRepo) GetTaskRevision(taskId uint64, revision int) (*models.TaskSQL, models.TaskTestsSQL, error) {
+	t := tr.revisions[revision]
+	return &t, models.TaskTestsSQL{}, nil
+}
+
+func (tr *taskRepo) GetTask(id uint64) (*models.TaskSQL, error) {
+	t := tr.private
+	return &t, nil
+}
+
+func (tr *taskRepo) CanViewTask(id uint64, uid uint64) (bool, error) {
+	return tr.grants[uid], nil
+}
+
+func (tr *taskRepo) IsCleared(taskId uint64, uid uint64) (bool, error) {
+	return false, nil
+}
+
+func (tr *taskRepo) GetTaskTags(taskId uint64) ([]string, error) {
+	return []string{}, nil
+}
+
+func (tr *taskRepo) GetBestScore(taskId uint64, uid uint64) (int, error) {
+	return 0, nil
+}
+
+// runner runs programs consisting of a single line: "repeat" prints its
+// input twice, "sum" prints the sum of digits, "fail" fails.
+type runner struct {
+	checker.Checker
+}
+
+func (r runner) Run(rs *models.RunSend) (*models.RunResult, error) {
+	for _, code := range rs.SourceCode {
+		switch code {
+		case "repeat":
+			return &models.RunResult{Verdict: models.VerdictAccepted, Stdout: rs.Stdin + rs.Stdin}, nil
+		case "sum":
+			sum := 0
+			for _, d := range rs.Stdin {
+				sum += int(d - '0')
+			}
+			return &models.RunResult{Verdict: models.VerdictAccepted, Stdout: strings.Repeat("1", sum)}, nil
+		}
+	}
+	return &models.RunResult{Verdict: models.VerdictRuntimeError, CheckMessage: "crashed"}, nil
+}
+
+func archive(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for name, text := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(text)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseTestsArchive(t *testing.T) {
+	tests, nums, err := usecase.ParseTestsArchive(archive(t, map[string]string{
+		"tests/10.in":  "5 5\n",
+		"tests/10.out": "10\n",
+		"tests/2.in":   "1 2\n",
+		"tests/2.out":  "3\n",
+		"tests/.keep":  "",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tests) != 2 || tests[0].Stdin != "1 2\n" || tests[0].Stdout != "3\n" || tests[1].Stdout != "10\n" {
+		t.Errorf("wrong tests: %+v", tests)
+	}
+	if len(nums) != 2 || nums[0] != 2 || nums[1] != 10 {
+		t.Errorf("wrong numbers of tests: %v", nums)
+	}
+
+	broken := []map[string]string{
+		{"1.in": "1"},
+		{"1.in": "1", "1.out": "1", "2.out": "2"},
+		{"1.in": "1", "1.out": "1", "readme.txt": "tests"},
+		{"1.in": "\xff\xfe", "1.out": "1"},
+		{},
+	}
+	for _, files := range broken {
+		if _, _, err := usecase.ParseTestsArchive(archive(t, files)); err == nil {
+			t.Errorf("archive %v must be rejected", files)
+		}
+	}
+
+	if _, _, err := usecase.ParseTestsArchive([]byte("not a zip")); err == nil {
+		t.Errorf("not a zip must be rejected")
+	}
+}
+
+func TestUploadTests(t *testing.T) {
+	data := archive(t, map[string]string{
+		"3.in": "1", "3.out": "1",
+		"7.in": "2", "7.out": "2",
+		"12.in": "3", "12.out": "3",
+	})
+	repo := &taskRepo{}
+	uc := usecase.NewTaskUseCase(repo, runner{})
+
+	if _, err := uc.UploadTests(7, 1, data, []int{7}, []int{12}); err != nil {
+		t.Fatal(err)
+	}
+	if len(repo.tests) != 3 || repo.tests[0].Sample || !repo.tests[1].Sample || !repo.tests[2].Hidden {
+		t.Errorf("tests must be marked by numbers of files: %+v", repo.tests)
+	}
+
+	if _, err := uc.UploadTests(7, 1, data, []int{2}, nil); err == nil {
+		t.Errorf("test 2 is not in the archive")
+	}
+}
+
+func TestGenerateTests(t *testing.T) {
+	g := models.TaskGenerator{
+		Generator: models.Program{Language: "python", SourceCode: map[string]interface{}{"gen.py": "repeat"}},
+		Reference: models.Program{Language: "c", SourceCode: map[string]interface{}{"main.c": "sum"}},
+		Script:    models.GeneratorScript{{Args: "1", Sample: true}, {Args: "2", Hidden: true}, {Args: "3"}},
+	}
+	repo := &taskRepo{generator: g.ConvertToSQL(7)}
+	uc := usecase.NewTaskUseCase(repo, runner{})
+
+	if _, err := uc.GenerateTests(7, 2); err == nil {
+		t.Errorf("only the author can generate tests")
+	}
+
+	if _, err := uc.GenerateTests(7, 1); err != nil {
+		t.Fatal(err)
+	}
+	if len(repo.tests) != 3 || repo.tests[1].Stdin != "22" || repo.tests[1].Stdout != "1111" ||
+		!repo.tests[0].Sample || repo.tests[1].Sample || !repo.tests[1].Hidden || repo.tests[2].Sample {
+		t.Errorf("wrong generated tests: %+v", repo.tests)
+	}
+
+	g.Reference.SourceCode = map[string]interface{}{"main.c": "fail"}
+	repo = &taskRepo{generator: g.ConvertToSQL(7)}
+	uc = usecase.NewTaskUseCase(repo, runner{})
+	if _, err := uc.GenerateTests(7, 1); err == nil || repo.tests != nil {
+		t.Errorf("tests must not be replaced when the reference fails")
+	}
+}
+
+func TestDiffRevisions(t *testing.T) {
+	old := models.TaskSQL{
+		Title:       "Sum",
+		Description: "Sum two numbers.\nThey are small.\n",
+		Tests:       `[["1 2","3"]]`,
+		Checker:     `{"mode":"exact"}`,
+		Subtasks:    `[]`,
+		TimeLimit:   1000,
+		MemoryLimit: 65536,
+		OutputLimit: 1024,
+	}
+	changed := old
+	changed.Description = "Sum two numbers.\nThey are big.\n"
+	changed.Tests = `[["1 2","3"],["5 5","10"]]`
+	tuc := usecase.NewTaskUseCase(&taskRepo{revisions: map[int]models.TaskSQL{1: old, 2: changed}}, nil)
+
+	if _, err := tuc.DiffRevisions(1, 2, 1, 2); err == nil {
+		t.Errorf("only those who manage the task can diff its revisions")
+	}
+
+	d, err := tuc.DiffRevisions(1, 1, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Files) != 2 || d.Files[0].Name != "description" || d.Files[1].Name != "tests" {
+		t.Fatalf("only description and tests changed: %+v", d.Files)
+	}
+	if !strings.Contains(d.Files[0].Unified, "-They are small.\n+They are big.\n") {
+		t.Errorf("wrong description diff:\n%s", d.Files[0].Unified)
+	}
+}
+
+func TestPrivateTask(t *testing.T) {
+	repo := &taskRepo{
+		private: models.TaskSQL{Id: 5, Creator: 1, IsPrivate: true, Tests: `[]`, Checker: `{}`, Subtasks: `[]`},
+		grants:  map[uint64]bool{2: true},
+	}
+	tuc := usecase.NewTaskUseCase(repo, nil)
+
+	for uid, visible := range map[uint64]bool{0: false, 1: true, 2: true, 3: false} {
+		_, err := tuc.GetTask(5, uid, false)
+		if visible && err != nil {
+			t.Errorf("user %d must see the task: %v", uid, err)
+		}
+		if !visible && err == nil {
+			t.Errorf("user %d must not see the private task", uid)
+		}
+	}
+
+	// submissions load the task for checking
+	if _, err := tuc.GetTask(5, 3, true); err == nil {
+		t.Errorf("private task can't be checked for a user without access")
+	}
+	if _, err := tuc.GetTaskSettings(5, 3); err == nil {
+		t.Errorf("user without access can't submit to the private task")
+	}
+
+	// solutions submitted before the grant was revoked are still judged
+	if _, err := tuc.GetTaskToJudge(5); err != nil {
+		t.Errorf("judge must load the private task: %v", err)
+	}
+}
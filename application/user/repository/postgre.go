@@ -110,7 +110,7 @@ func (ud *UserDatabase) CheckUser(usr models.UserAuth) (*models.User, error) {
 	 FROM users WHERE lower(username) = $1`, strings.ToLower(usr.Username)).Scan(&gotUser.Id, &gotUser.Username, &gotUser.Fullname, &gotUser.Password,
 		&gotUser.Email)
 
-	if errors.As(err, &sql.ErrNoRows) {
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
 	if err != nil {
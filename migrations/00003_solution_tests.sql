@@ -0,0 +1,13 @@
+CREATE TABLE solution_tests
+(
+    id          bigserial primary key,
+    solution_id bigint references solutions (id) on delete cascade,
+    test_num    int not null,
+    passed      boolean not null default false,
+    verdict     int not null default 0,
+    stdout      text not null default '',
+    stderr      text not null default '',
+    run_time    double precision not null default 0.0,
+    memory      bigint not null default 0,
+    UNIQUE (solution_id, test_num)
+);
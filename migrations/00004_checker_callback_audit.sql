@@ -0,0 +1,10 @@
+CREATE TABLE checker_callback_audit
+(
+    id                 bigserial primary key,
+    solution_id        bigint not null,
+    remote_addr        text not null default '',
+    reason             text not null,
+    signature          text not null default '',
+    body               text not null default '',
+    received_date_time TIMESTAMP WITH TIME ZONE not null
+);
@@ -0,0 +1,9 @@
+ALTER TABLE tasks ADD COLUMN checker text not null default '{"mode":"exact"}';
+
+DROP TRIGGER update_solution ON tasks;
+
+CREATE TRIGGER update_solution
+    AFTER UPDATE ON tasks
+    FOR EACH ROW
+    WHEN (OLD.tests is DISTINCT FROM NEW.tests OR OLD.checker is DISTINCT FROM NEW.checker)
+    EXECUTE FUNCTION update_solution();
@@ -0,0 +1,13 @@
+ALTER TABLE tasks ADD COLUMN time_limit int not null default 1000;
+ALTER TABLE tasks ADD COLUMN memory_limit int not null default 262144;
+ALTER TABLE tasks ADD COLUMN output_limit int not null default 16384;
+
+DROP TRIGGER update_solution ON tasks;
+
+CREATE TRIGGER update_solution
+    AFTER UPDATE ON tasks
+    FOR EACH ROW
+    WHEN (OLD.tests is DISTINCT FROM NEW.tests OR OLD.checker is DISTINCT FROM NEW.checker
+        OR OLD.time_limit is DISTINCT FROM NEW.time_limit OR OLD.memory_limit is DISTINCT FROM NEW.memory_limit
+        OR OLD.output_limit is DISTINCT FROM NEW.output_limit)
+    EXECUTE FUNCTION update_solution();
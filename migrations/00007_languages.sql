@@ -0,0 +1,2 @@
+ALTER TABLE solutions ADD COLUMN language text not null default 'c';
+ALTER TABLE tasks ADD COLUMN allowed_languages text[] not null default '{}';
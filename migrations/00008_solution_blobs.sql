@@ -0,0 +1,2 @@
+-- source_code keeps the key of the blob with the solution files
+CREATE INDEX solutions_source_code_idx ON solutions (source_code);
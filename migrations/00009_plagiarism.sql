@@ -0,0 +1,16 @@
+-- similar pairs found by the last plagiarism check of a task
+CREATE TABLE plagiarism_pairs
+(
+    id                bigserial primary key,
+    task_id           bigint references tasks (id) on delete cascade,
+    language          text not null,
+    solution_a        bigint references solutions (id) on delete cascade,
+    uid_a             bigint references users (id) on delete cascade,
+    solution_b        bigint references solutions (id) on delete cascade,
+    uid_b             bigint references users (id) on delete cascade,
+    score             double precision not null,
+    matches           text not null default '[]',
+    checked_date_time TIMESTAMP WITH TIME ZONE not null
+);
+
+CREATE INDEX plagiarism_pairs_task_id_idx ON plagiarism_pairs (task_id, score DESC);
@@ -0,0 +1,3 @@
+-- keyset pages of solutions history go by id
+CREATE INDEX solutions_uid_id_idx ON solutions (uid, id);
+CREATE INDEX solutions_task_id_id_idx ON solutions (task_id, id);
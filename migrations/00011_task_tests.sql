@@ -0,0 +1,31 @@
+-- tests uploaded as an archive live in blob storage, tasks.tests_key is the
+-- key of their manifest and is empty for tasks with inline tests
+CREATE TABLE task_tests
+(
+    id          bigserial primary key,
+    task_id     bigint references tasks (id) on delete cascade,
+    test_num    int    not null,
+    input_key   text   not null,
+    output_key  text   not null,
+    input_size  int    not null,
+    output_size int    not null,
+    sample      bool   not null default false,
+    hidden      bool   not null default false,
+    note        text   not null default '',
+    UNIQUE (task_id, test_num)
+);
+
+CREATE INDEX task_tests_input_key_idx ON task_tests (input_key);
+CREATE INDEX task_tests_output_key_idx ON task_tests (output_key);
+
+ALTER TABLE tasks ADD COLUMN tests_key text not null default '';
+
+DROP TRIGGER update_solution ON tasks;
+
+CREATE TRIGGER update_solution
+    AFTER UPDATE ON tasks
+    FOR EACH ROW
+    WHEN (OLD.tests is DISTINCT FROM NEW.tests OR OLD.checker is DISTINCT FROM NEW.checker
+        OR OLD.time_limit is DISTINCT FROM NEW.time_limit OR OLD.memory_limit is DISTINCT FROM NEW.memory_limit
+        OR OLD.output_limit is DISTINCT FROM NEW.output_limit OR OLD.tests_key is DISTINCT FROM NEW.tests_key)
+    EXECUTE FUNCTION update_solution();
@@ -0,0 +1,10 @@
+-- generator and reference solution tests of a task are rebuilt with
+CREATE TABLE task_generators
+(
+    task_id            bigint primary key references tasks (id) on delete cascade,
+    generator_language text not null,
+    generator_code     text not null,
+    reference_language text not null,
+    reference_code     text not null,
+    script             text not null default '[]'
+);
@@ -0,0 +1,29 @@
+ALTER TABLE tasks ADD COLUMN subtasks text not null default '[]';
+ALTER TABLE tasks ADD COLUMN max_score int not null default 100;
+
+ALTER TABLE solutions ADD COLUMN score int not null default 0;
+-- tasks had no subtasks, accepted solutions get all the points
+UPDATE solutions SET score = 100 WHERE check_result = 0;
+
+-- best score of a user for a task, tasks_done still marks fully solved ones
+CREATE TABLE task_scores
+(
+    uid     bigint references users (id) on delete cascade,
+    task_id bigint references tasks (id) on delete cascade,
+    score   int not null default 0,
+    PRIMARY KEY (uid, task_id)
+);
+
+INSERT INTO task_scores (uid, task_id, score)
+SELECT uid, task_id, max(score) FROM solutions WHERE uid IS NOT NULL AND task_id IS NOT NULL GROUP BY uid, task_id;
+
+DROP TRIGGER update_solution ON tasks;
+
+CREATE TRIGGER update_solution
+    AFTER UPDATE ON tasks
+    FOR EACH ROW
+    WHEN (OLD.tests is DISTINCT FROM NEW.tests OR OLD.checker is DISTINCT FROM NEW.checker
+        OR OLD.time_limit is DISTINCT FROM NEW.time_limit OR OLD.memory_limit is DISTINCT FROM NEW.memory_limit
+        OR OLD.output_limit is DISTINCT FROM NEW.output_limit OR OLD.tests_key is DISTINCT FROM NEW.tests_key
+        OR OLD.subtasks is DISTINCT FROM NEW.subtasks)
+    EXECUTE FUNCTION update_solution();
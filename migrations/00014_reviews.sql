@@ -0,0 +1,25 @@
+-- review threads on lines of solution files and their comments
+CREATE TABLE review_threads
+(
+    id                bigserial primary key,
+    solution_id       bigint references solutions (id) on delete cascade,
+    file_name         text not null,
+    line_start        int  not null,
+    line_end          int  not null,
+    uid               bigint references users (id) on delete cascade,
+    resolved          bool not null default false,
+    created_date_time TIMESTAMP WITH TIME ZONE not null
+);
+
+CREATE INDEX review_threads_solution_id_idx ON review_threads (solution_id);
+
+CREATE TABLE review_comments
+(
+    id                bigserial primary key,
+    thread_id         bigint references review_threads (id) on delete cascade,
+    uid               bigint references users (id) on delete cascade,
+    text              text not null,
+    created_date_time TIMESTAMP WITH TIME ZONE not null
+);
+
+CREATE INDEX review_comments_thread_id_idx ON review_comments (thread_id, id);
@@ -0,0 +1,18 @@
+ALTER TABLE tasks ADD COLUMN difficulty int not null default 0;
+
+CREATE TABLE tags
+(
+    id   bigserial primary key,
+    name text not null,
+    UNIQUE (name)
+);
+
+CREATE TABLE task_tags
+(
+    task_id bigint references tasks (id) on delete cascade,
+    tag_id  bigint references tags (id) on delete cascade,
+    PRIMARY KEY (task_id, tag_id)
+);
+
+CREATE INDEX task_tags_tag_id_idx ON task_tags (tag_id);
+CREATE INDEX tasks_difficulty_idx ON tasks (difficulty);
@@ -0,0 +1,9 @@
+-- titles weigh more than descriptions, russian and english stems are both kept
+ALTER TABLE tasks ADD COLUMN search tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(description, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B')
+) STORED;
+
+CREATE INDEX tasks_search_idx ON tasks USING GIN (search);
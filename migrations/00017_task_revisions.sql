@@ -0,0 +1,63 @@
+-- every change of a task saves a copy of it, stored tests of the copy are
+-- kept in task_revision_tests, so their blobs are not deleted
+ALTER TABLE tasks ADD COLUMN revision int not null default 1;
+
+CREATE TABLE task_revisions
+(
+    id                bigserial primary key,
+    task_id           bigint references tasks (id) on delete cascade,
+    revision          int       not null,
+    author            bigint    references users (id) on delete set null,
+    date              timestamp not null,
+    title             text      not null,
+    description       text      not null,
+    hints             text,
+    input             text      not null,
+    output            text      not null,
+    test_amount       int       not null,
+    tests             text      not null,
+    tests_key         text      not null,
+    subtasks          text      not null,
+    max_score         int       not null,
+    difficulty        int       not null,
+    checker           text      not null,
+    time_limit        int       not null,
+    memory_limit      int       not null,
+    output_limit      int       not null,
+    allowed_languages text[]    not null,
+    UNIQUE (task_id, revision)
+);
+
+CREATE TABLE task_revision_tests
+(
+    revision_id bigint references task_revisions (id) on delete cascade,
+    test_num    int  not null,
+    input_key   text not null,
+    output_key  text not null,
+    input_size  int  not null,
+    output_size int  not null,
+    sample      bool not null,
+    hidden      bool not null,
+    note        text not null,
+    PRIMARY KEY (revision_id, test_num)
+);
+
+CREATE INDEX task_revision_tests_input_key_idx ON task_revision_tests (input_key);
+CREATE INDEX task_revision_tests_output_key_idx ON task_revision_tests (output_key);
+
+INSERT INTO task_revisions (task_id, revision, author, date, title, description, hints, input, output,
+                            test_amount, tests, tests_key, subtasks, max_score, difficulty, checker,
+                            time_limit, memory_limit, output_limit, allowed_languages)
+SELECT id, revision, creator, date, title, description, hints, input, output,
+       test_amount, tests, tests_key, subtasks, max_score, difficulty, checker,
+       time_limit, memory_limit, output_limit, allowed_languages
+FROM tasks;
+
+INSERT INTO task_revision_tests (revision_id, test_num, input_key, output_key, input_size, output_size,
+                                 sample, hidden, note)
+SELECT r.id, tt.test_num, tt.input_key, tt.output_key, tt.input_size, tt.output_size, tt.sample, tt.hidden, tt.note
+FROM task_tests tt
+         JOIN task_revisions r ON r.task_id = tt.task_id;
+
+-- zero for solutions judged before revisions
+ALTER TABLE solutions ADD COLUMN task_revision int not null default 0;
@@ -0,0 +1,17 @@
+-- private tasks are seen by their creators and by users with a grant, the
+-- code of a private task is its invite, users joining by it get a grant
+CREATE TABLE task_grants
+(
+    task_id bigint references tasks (id) on delete cascade,
+    uid     bigint references users (id) on delete cascade,
+    date    timestamp not null,
+    PRIMARY KEY (task_id, uid)
+);
+
+CREATE INDEX task_grants_uid_idx ON task_grants (uid);
+
+-- codes were never checked before, so they are issued anew
+UPDATE tasks SET code = NULL WHERE is_private = false;
+UPDATE tasks SET code = substr(md5(random()::text || id::text), 1, 10) WHERE is_private = true;
+
+CREATE UNIQUE INDEX tasks_code_idx ON tasks (code);
@@ -0,0 +1,15 @@
+-- plagiarism checks run in background, the row keeps the state of the
+-- latest requested check and the totals of the last finished one
+CREATE TABLE plagiarism_reports
+(
+    task_id             bigint primary key references tasks (id) on delete cascade,
+    status              text not null,
+    solutions           int  not null default 0,
+    pairs               int  not null default 0,
+    error               text not null default '',
+    requested_date_time TIMESTAMP WITH TIME ZONE not null,
+    started_date_time   TIMESTAMP WITH TIME ZONE,
+    checked_date_time   TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX plagiarism_reports_status_idx ON plagiarism_reports (status, requested_date_time);
@@ -0,0 +1,13 @@
+-- solutions being judged when tests change are not marked stale at once,
+-- they would be claimed for rejudging while the running job still judges
+-- them; they are flagged instead and become stale when the job saves its
+-- verdict, which is based on the old tests
+ALTER TABLE solutions ADD COLUMN rejudge boolean not null default false;
+
+CREATE OR REPLACE FUNCTION update_solution() RETURNS trigger AS $update_solution$
+BEGIN
+UPDATE solutions SET check_result = 5 WHERE task_id = OLD.id AND check_result NOT IN (1, 9, 10);
+UPDATE solutions SET rejudge = true WHERE task_id = OLD.id AND check_result IN (1, 9, 10);
+RETURN NEW;
+END;
+$update_solution$ LANGUAGE plpgsql;
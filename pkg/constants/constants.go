@@ -10,6 +10,29 @@ const (
 	CountKey            = "count"
 	TaskId              = "taskId"
 	SolutionId          = "solutionId"
+	VerdictKey          = "verdict"
+	PairId              = "pairId"
+	OtherSolutionId     = "otherId"
+	ThreadId            = "threadId"
+	RevisionKey         = "revision"
+	CursorKey           = "cursor"
+	OrderKey            = "order"
+	LanguageKey         = "language"
+	FromKey             = "from"
+	ToKey               = "to"
+	ScopeKey            = "scope"
+	UserKey             = "user"
+	TaskKey             = "task"
+	NextCursorHeader    = "X-Next-Cursor"
+	ArchiveKey          = "archive"
+	SamplesKey          = "samples"
+	HiddenKey           = "hidden"
+	TagsKey             = "tags"
+	ExcludeTagsKey      = "excludeTags"
+	MinDifficultyKey    = "minDifficulty"
+	MaxDifficultyKey    = "maxDifficulty"
+	SolutionsPerPage    = 10
+	MaxSolutionsPerPage = 100
 	TasksPerPage        = 100
 	WeekSec             = 604800
 	DBConnect           = " dbname=liokoredu host=localhost port=5432 sslmode=disable pool_max_conns=10"
@@ -27,6 +50,125 @@ const (
 	MaxSizeKB           = 8184
 	SignKey             = "liokoredu"
 
+	// Time the checker gets on top of the time needed to compile the
+	// solution and to run it on every test.
+	CheckerTimeout = 60 * time.Second
+	// How often the checker is polled for a verdict.
+	CheckerPollInterval = 500 * time.Millisecond
+
+	// Environment variable with the secret shared with the checker.
+	CheckerSecretEnv = "LIOKOR_CHECKER_SECRET"
+	// Checker callbacks older or newer than this are rejected.
+	CheckerCallbackWindow = 5 * time.Minute
+	// Nonces of dispatched jobs live this long.
+	CheckerNonceTTL    = time.Hour
+	CheckerNonceLength = uint8(32)
+	// Max size of a checker callback body.
+	CheckerCallbackMaxSize = 16 * 1024 * 1024
+
+	// Max stored program output per test, longer outputs are cut.
+	TestStdoutLength = 64 * 1024
+	// Max stored stderr excerpt per test.
+	TestStderrLength = 2 * 1024
+
+	// Language of solutions submitted without one.
+	DefaultLanguage = "c"
+
+	// Default limits of a test run: milliseconds, kilobytes, kilobytes.
+	TaskTimeLimit   = 1000
+	TaskMemoryLimit = 256 * 1024
+	TaskOutputLimit = 16 * 1024
+	// Max limits an author can set.
+	TaskMaxTimeLimit   = 15000
+	TaskMaxMemoryLimit = 1024 * 1024
+	TaskMaxOutputLimit = 64 * 1024
+
+	// Name of the judge backend, "local" for the embedded one, the remote
+	// checker is used otherwise.
+	CheckerBackendEnv = "LIOKOR_CHECKER"
+	// Command the embedded judge starts programs with.
+	JudgeWrapperEnv = "LIOKOR_JUDGE_WRAPPER"
+	// Set to run programs of the embedded judge in separate namespaces.
+	JudgeNamespacesEnv = "LIOKOR_JUDGE_NAMESPACES"
+	// Limits of compilers and custom checkers in the embedded judge.
+	JudgeCompileTimeout = 30 * time.Second
+	JudgeCheckerTimeout = 10 * time.Second
+	JudgeCompileMemory  = 1024 * 1024
+	// How often memory of running programs is sampled.
+	JudgeMemorySampleInterval = 5 * time.Millisecond
+
+	// Blob storage of solutions, "s3" for an S3 compatible one, files in
+	// SolutionsDir are used otherwise.
+	BlobStoreEnv   = "LIOKOR_BLOBSTORE"
+	S3EndpointEnv  = "LIOKOR_S3_ENDPOINT"
+	S3BucketEnv    = "LIOKOR_S3_BUCKET"
+	S3RegionEnv    = "LIOKOR_S3_REGION"
+	S3AccessKeyEnv = "LIOKOR_S3_ACCESS_KEY"
+	S3SecretKeyEnv = "LIOKOR_S3_SECRET_KEY"
+	BlobTimeout    = 30 * time.Second
+
+	// Number of judge workers pulling solutions from the queue, JudgeWorkers
+	// unless set in JudgeWorkersEnv.
+	JudgeWorkersEnv = "LIOKOR_JUDGE_WORKERS"
+	JudgeWorkers    = 4
+	// Attempts to check a solution before it goes to the dead letter queue.
+	JudgeMaxAttempts = 3
+	// Base delay between attempts, multiplied by the attempt number.
+	JudgeRetryDelay = 5 * time.Second
+	// How long a worker waits for a job in a single queue request.
+	JudgePopTimeout = 5 * time.Second
+	// Jobs of an instance which hasn't renewed its lease for JudgeLeaseTTL
+	// are returned to the queue.
+	JudgeLeaseTTL          = 30 * time.Second
+	JudgeHeartbeatInterval = 10 * time.Second
+	// How often stale solutions are looked for and queued for rejudging.
+	RejudgeSweepInterval = 10 * time.Second
+	// Max stale solutions claimed at once.
+	RejudgeBatchSize = 100
+	// How long rejudge progress is kept.
+	RejudgeProgressTTL = 7 * 24 * time.Hour
+
+	// Length of token k-grams hashed for plagiarism detection.
+	PlagiarismKGram = 10
+	// Winnowing window, shared runs of PlagiarismKGram+PlagiarismWindow-1
+	// tokens are always found.
+	PlagiarismWindow = 5
+	// Pairs of solutions at least this similar are reported.
+	PlagiarismThreshold = 0.6
+	// How often queued plagiarism checks are looked for.
+	PlagiarismSweepInterval = 10 * time.Second
+	// A check running for longer is considered abandoned and is run again.
+	PlagiarismCheckTimeout = 30 * time.Minute
+
+	// Max size of an uploaded archive with tests and of all files in it.
+	TestsArchiveSize  = 64 << 20
+	TestsUnpackedSize = 256 << 20
+	// Max number of tests of a task.
+	TestsMaxCount = 1000
+	// Tags of a task and their length.
+	TaskMaxTags = 10
+	TagLength   = 32
+	// Difficulty levels an author can set, 0 means the task has none.
+	MinDifficulty = 1
+	MaxDifficulty = 10
+
+	// Points for solving a task without subtasks.
+	DefaultMaxScore = 100
+
+	// Runs on custom input allowed to a user per RunRateWindow.
+	RunRateLimit  = 10
+	RunRateWindow = time.Minute
+	// Max size of custom input of a run.
+	RunStdinLength = 64 * 1024
+
+	// Max length of a review comment.
+	ReviewCommentLength = 8 * 1024
+
+	// Unchanged lines shown around changes in diffs.
+	DiffContext = 3
+	// Files which differ in more lines are diffed roughly.
+	DiffMaxEdits = 1000
+
 	// Time allowed to read the next pong message from the peer.
 	PongWait = 10 * time.Second
 	// Send pings to peer with this period. Must be less than pongWait.
@@ -0,0 +1,303 @@
+package diff
+
+import (
+	"liokoredu/application/models"
+	"liokoredu/pkg/constants"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type op int
+
+const (
+	opEqual op = iota
+	opDelete
+	opInsert
+)
+
+// edit is a single line of an edit script, a and b are indexes of the line
+// in the old and the new text.
+type edit struct {
+	op   op
+	a, b int
+}
+
+// SplitLines splits text into lines keeping their "\n", so a missing newline
+// at the end of a file is a change too.
+func SplitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// edits finds the shortest edit script with the Myers algorithm. Texts which
+// differ in more than DiffMaxEdits lines are not worth it, the rest of them
+// is replaced as a whole.
+func edits(a []string, b []string) []edit {
+	n, m := len(a), len(b)
+	off := n + m + 1
+	v := make([]int, 2*off+1)
+	var trace [][]int
+
+	d := 0
+	found := false
+	x, y := 0, 0
+	for ; d <= n+m && d <= constants.DiffMaxEdits; d++ {
+		trace = append(trace, append([]int{}, v[off-d:off+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			if k == -d || k != d && v[off+k-1] < v[off+k+1] {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y = x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+		if found {
+			break
+		}
+	}
+
+	script := []edit{}
+	if !found {
+		// go back from the furthest point reached and replace the rest,
+		// the script is built from its end
+		d--
+		x, y = 0, 0
+		for k := -d; k <= d; k += 2 {
+			kx, ky := v[off+k], v[off+k]-k
+			if kx <= n && ky >= 0 && ky <= m && kx+ky > x+y {
+				x, y = kx, ky
+			}
+		}
+		for j := m - 1; j >= y; j-- {
+			script = append(script, edit{op: opInsert, a: n, b: j})
+		}
+		for i := n - 1; i >= x; i-- {
+			script = append(script, edit{op: opDelete, a: i, b: y})
+		}
+	}
+
+	for ; d >= 0; d-- {
+		vd := trace[d]
+		at := func(k int) int { return vd[k+d] }
+		k := x - y
+		var prevK int
+		if k == -d || k != d && at(k-1) < at(k+1) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := 0
+		if d > 0 {
+			prevX = at(prevK)
+		}
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			script = append(script, edit{op: opEqual, a: x, b: y})
+		}
+		if d > 0 {
+			if x == prevX {
+				script = append(script, edit{op: opInsert, a: x, b: y - 1})
+			} else {
+				script = append(script, edit{op: opDelete, a: x - 1, b: y})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	reverse(script)
+
+	return script
+}
+
+func reverse(script []edit) {
+	for i, j := 0, len(script)-1; i < j; i, j = i+1, j-1 {
+		script[i], script[j] = script[j], script[i]
+	}
+}
+
+// Hunks diffs two texts line by line, every hunk has up to context unchanged
+// lines around its changes.
+func Hunks(a []string, b []string, context int) models.DiffHunks {
+	script := edits(a, b)
+	hunks := models.DiffHunks{}
+
+	for i := 0; i < len(script); {
+		if script[i].op == opEqual {
+			i++
+			continue
+		}
+
+		// extend the hunk while changes are close enough
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for j := i; j < len(script); j++ {
+			if script[j].op != opEqual {
+				end = j
+			} else if j-end > 2*context {
+				break
+			}
+		}
+		stop := end + context + 1
+		if stop > len(script) {
+			stop = len(script)
+		}
+
+		hunks = append(hunks, hunk(script[start:stop], a, b))
+		i = stop
+	}
+
+	return hunks
+}
+
+func hunk(script []edit, a []string, b []string) models.DiffHunk {
+	h := models.DiffHunk{
+		OldStart: script[0].a + 1,
+		NewStart: script[0].b + 1,
+		Lines:    models.DiffLines{},
+	}
+	for _, e := range script {
+		line := models.DiffLine{}
+		switch e.op {
+		case opEqual:
+			line.Kind = models.DiffLineContext
+			line.Text = a[e.a]
+			line.OldLine = e.a + 1
+			line.NewLine = e.b + 1
+			h.OldLines++
+			h.NewLines++
+		case opDelete:
+			line.Kind = models.DiffLineRemoved
+			line.Text = a[e.a]
+			line.OldLine = e.a + 1
+			h.OldLines++
+		case opInsert:
+			line.Kind = models.DiffLineAdded
+			line.Text = b[e.b]
+			line.NewLine = e.b + 1
+			h.NewLines++
+		}
+		h.Lines = append(h.Lines, line)
+	}
+	// empty ranges point at the line before them
+	if h.OldLines == 0 {
+		h.OldStart--
+	}
+	if h.NewLines == 0 {
+		h.NewStart--
+	}
+
+	return h
+}
+
+// Unified prints hunks in the unified diff format, an empty name stands for
+// a missing file.
+func Unified(oldName string, newName string, hunks models.DiffHunks) string {
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString("--- " + header("a/", oldName) + "\n")
+	sb.WriteString("+++ " + header("b/", newName) + "\n")
+	for _, h := range hunks {
+		sb.WriteString("@@ -" + span(h.OldStart, h.OldLines) + " +" + span(h.NewStart, h.NewLines) + " @@\n")
+		for _, line := range h.Lines {
+			switch line.Kind {
+			case models.DiffLineAdded:
+				sb.WriteString("+")
+			case models.DiffLineRemoved:
+				sb.WriteString("-")
+			default:
+				sb.WriteString(" ")
+			}
+			sb.WriteString(line.Text)
+			if !strings.HasSuffix(line.Text, "\n") {
+				sb.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+func header(prefix string, name string) string {
+	if name == "" {
+		return "/dev/null"
+	}
+	return prefix + name
+}
+
+func span(start int, lines int) string {
+	if lines == 1 {
+		return strconv.Itoa(start)
+	}
+	return strconv.Itoa(start) + "," + strconv.Itoa(lines)
+}
+
+// Files diffs two versions of solution files. Unchanged files are left out,
+// files which are not texts are compared as empty ones.
+func Files(old map[string]interface{}, new map[string]interface{}) models.FileDiffs {
+	names := []string{}
+	for name := range old {
+		names = append(names, name)
+	}
+	for name := range new {
+		if _, ok := old[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	files := models.FileDiffs{}
+	for _, name := range names {
+		oldText, inOld := old[name]
+		newText, inNew := new[name]
+
+		fd := models.FileDiff{Name: name, Status: models.FileModified}
+		oldName, newName := name, name
+		switch {
+		case !inOld:
+			fd.Status = models.FileAdded
+			oldName = ""
+		case !inNew:
+			fd.Status = models.FileRemoved
+			newName = ""
+		}
+
+		a := SplitLines(text(oldText))
+		b := SplitLines(text(newText))
+		fd.Hunks = Hunks(a, b, constants.DiffContext)
+		if fd.Status == models.FileModified && len(fd.Hunks) == 0 {
+			continue
+		}
+		fd.Unified = Unified(oldName, newName, fd.Hunks)
+		files = append(files, fd)
+	}
+
+	return files
+}
+
+func text(content interface{}) string {
+	s, _ := content.(string)
+	return s
+}
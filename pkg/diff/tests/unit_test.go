@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"math/rand"
+	"testing"
+
+	"liokoredu/application/models"
+	"liokoredu/pkg/diff"
+)
+
+func TestFiles(t *testing.T) {
+	old := map[string]interface{}{
+		"main.c": "#include <stdio.h>\n\nint main() {\n    int a, b;\n    scanf(\"%d %d\", &a, &b);\n" +
+			"    printf(\"%d\\n\", a + b);\n    return 0;\n}\n",
+		"util.h": "int sum(int a, int b);\n",
+	}
+	new := map[string]interface{}{
+		"main.c": "#include <stdio.h>\n\nint main() {\n    long a, b;\n    scanf(\"%ld %ld\", &a, &b);\n" +
+			"    printf(\"%ld\\n\", a + b);\n    return 0;\n}",
+		"Makefile": "all:\n\tgcc main.c\n",
+	}
+
+	files := diff.Files(old, new)
+	if len(files) != 3 {
+		t.Fatalf("wrong number of changed files: %d", len(files))
+	}
+
+	if files[0].Name != "Makefile" || files[0].Status != models.FileAdded ||
+		files[0].Unified != "--- /dev/null\n+++ b/Makefile\n@@ -0,0 +1,2 @@\n+all:\n+\tgcc main.c\n" {
+		t.Errorf("wrong diff of added file: %+v", files[0])
+	}
+
+	expected := "--- a/main.c\n+++ b/main.c\n@@ -1,8 +1,8 @@\n #include <stdio.h>\n \n int main() {\n" +
+		"-    int a, b;\n-    scanf(\"%d %d\", &a, &b);\n-    printf(\"%d\\n\", a + b);\n" +
+		"+    long a, b;\n+    scanf(\"%ld %ld\", &a, &b);\n+    printf(\"%ld\\n\", a + b);\n" +
+		"     return 0;\n-}\n+}\n\\ No newline at end of file\n"
+	if files[1].Name != "main.c" || files[1].Status != models.FileModified || files[1].Unified != expected {
+		t.Errorf("wrong diff of modified file:\n%s", files[1].Unified)
+	}
+	if len(files[1].Hunks) != 1 || files[1].Hunks[0].Lines[3].Kind != models.DiffLineRemoved ||
+		files[1].Hunks[0].Lines[3].OldLine != 4 || files[1].Hunks[0].Lines[6].NewLine != 4 {
+		t.Errorf("wrong hunks of modified file: %+v", files[1].Hunks)
+	}
+
+	if files[2].Name != "util.h" || files[2].Status != models.FileRemoved ||
+		files[2].Unified != "--- a/util.h\n+++ /dev/null\n@@ -1 +0,0 @@\n-int sum(int a, int b);\n" {
+		t.Errorf("wrong diff of removed file: %+v", files[2])
+	}
+
+	if len(diff.Files(old, old)) != 0 {
+		t.Errorf("same files differ")
+	}
+}
+
+func TestHunksAreMinimal(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := func() []string {
+		lines := make([]string, rnd.Intn(30))
+		for i := range lines {
+			lines[i] = string(rune('a' + rnd.Intn(4)))
+		}
+		return lines
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := random(), random()
+
+		var old, new []string
+		changes := 0
+		for _, h := range diff.Hunks(a, b, len(a)+len(b)) {
+			for _, line := range h.Lines {
+				if line.Kind != models.DiffLineAdded {
+					old = append(old, line.Text)
+				}
+				if line.Kind != models.DiffLineRemoved {
+					new = append(new, line.Text)
+				}
+				if line.Kind != models.DiffLineContext {
+					changes++
+				}
+			}
+		}
+
+		if changes == 0 {
+			old, new = a, b
+		}
+		if !equal(old, a) || !equal(new, b) {
+			t.Fatalf("diff of %v and %v does not restore them", a, b)
+		}
+		if changes != len(a)+len(b)-2*lcs(a, b) {
+			t.Fatalf("diff of %v and %v is not minimal: %d changes", a, b, changes)
+		}
+	}
+}
+
+func equal(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func lcs(a []string, b []string) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else if dp[i+1][j] > dp[i][j+1] {
+				dp[i][j] = dp[i+1][j]
+			} else {
+				dp[i][j] = dp[i][j+1]
+			}
+		}
+	}
+	return dp[0][0]
+}